@@ -0,0 +1,42 @@
+package db
+
+//Alterer is implemented by drivers (and their transactions) that can change the columns of existing tables.
+//Sync only ever adds columns, so migrations use these to rename or drop a column, or to change its type.
+type Alterer interface {
+	//RenameColumn renames a column of the named table.
+	RenameColumn(table, from, to string) error
+
+	//DropColumn removes a column, along with its values, from the named table.
+	DropColumn(table, column string) error
+
+	//AlterColumn changes the column of the named table with the same name as the given column to the type of the given column.
+	//Existing values are converted to the new type.
+	AlterColumn(table string, column Column) error
+}
+
+//RenameColumn renames a column of the named table.
+//Returns ErrUnsupported if the driver cannot alter tables.
+func RenameColumn(driver Driver, table, from, to string) error {
+	if alterer, ok := driver.(Alterer); ok {
+		return alterer.RenameColumn(table, from, to)
+	}
+	return ErrUnsupported
+}
+
+//DropColumn removes a column, along with its values, from the named table.
+//Returns ErrUnsupported if the driver cannot alter tables.
+func DropColumn(driver Driver, table, column string) error {
+	if alterer, ok := driver.(Alterer); ok {
+		return alterer.DropColumn(table, column)
+	}
+	return ErrUnsupported
+}
+
+//AlterColumn changes the column of the named table with the same name as the given column to the type of the given column.
+//Returns ErrUnsupported if the driver cannot alter tables.
+func AlterColumn(driver Driver, table string, column Column) error {
+	if alterer, ok := driver.(Alterer); ok {
+		return alterer.AlterColumn(table, column)
+	}
+	return ErrUnsupported
+}
@@ -0,0 +1,283 @@
+package db
+
+import (
+	"errors"
+	"reflect"
+	"unicode"
+	"unicode/utf8"
+)
+
+//alter replaces the named table with a copy that has the fields returned by change.
+//The values of each field are copied from the column that renames maps to it, or else from the column with the same name,
+//and converted to the type of the field. Indexes and keys on columns that no longer exist are dropped.
+func alter(db tables, name string, change func(rtype reflect.Type) ([]reflect.StructField, map[string]string, error)) error {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	var existing = db.lookup(name)
+	if existing == nil {
+		return ErrTableNotFound
+	}
+
+	fields, renames, err := change(existing.rtype)
+	if err != nil {
+		return err
+	}
+
+	var sources = make(map[string]string, len(fields))
+	for _, field := range fields {
+		sources[field.Name] = field.Name
+	}
+	for from, to := range renames {
+		sources[to] = from
+	}
+
+	//moved returns the name that the given column has after the change, false if it was dropped.
+	moved := func(column string) (string, bool) {
+		if to, ok := renames[column]; ok {
+			return to, true
+		}
+		return column, sources[column] == column
+	}
+
+	var rtype = reflect.StructOf(fields)
+
+	//The slice must be addressable, so that rows can be appended to it.
+	var slice = reflect.New(reflect.SliceOf(rtype)).Elem()
+	slice.Set(reflect.MakeSlice(slice.Type(), existing.slice.Len(), existing.slice.Len()))
+
+	var table = &storage{
+		rtype:    rtype,
+		slice:    slice,
+		indexes:  make(map[string]map[interface{}][]int),
+		counters: make(map[string]int64),
+		decimals: make(map[string][2]int),
+	}
+
+	for column := range existing.indexes {
+		if column, ok := moved(column); ok {
+			table.indexes[column] = make(map[interface{}][]int)
+		}
+	}
+	for column := range existing.counters {
+		if column, ok := moved(column); ok {
+			table.counters[column] = 0
+		}
+	}
+	for column, digits := range existing.decimals {
+		if column, ok := moved(column); ok {
+			table.decimals[column] = digits
+		}
+	}
+
+uniques:
+	for _, unique := range existing.uniques {
+		var columns = make([]string, len(unique.Columns))
+		for i, column := range unique.Columns {
+			var ok bool
+			if columns[i], ok = moved(column); !ok {
+				continue uniques
+			}
+		}
+		table.uniques = append(table.uniques, Index{Name: unique.Name, Columns: columns, Unique: true})
+	}
+
+	for _, key := range existing.foreigns {
+		if column, ok := moved(key.Column); ok {
+			key.Column = column
+			table.foreigns = append(table.foreigns, key)
+		}
+	}
+
+	for i := 0; i < existing.slice.Len(); i++ {
+		var from, to = existing.slice.Index(i), table.slice.Index(i)
+		for j, field := range fields {
+			var value = from.FieldByName(sources[field.Name])
+			if !value.IsValid() {
+				continue
+			}
+			converted, err := convert(value, field.Type)
+			if err != nil {
+				return errors.New("db: cannot alter column " + field.Name + " of " + name + ": " + err.Error())
+			}
+			to.Field(j).Set(converted)
+		}
+	}
+
+	table.reindex()
+	for i := 0; i < table.slice.Len(); i++ {
+		table.count(i)
+	}
+
+	//Foreign keys of other tables follow the columns they reference, referenced columns cannot be dropped.
+	var references = make(map[string][]ForeignKey)
+	for _, other := range db.names() {
+		var referencing = db.lookup(other)
+		if other == name || referencing == nil {
+			continue
+		}
+		for i, key := range referencing.foreigns {
+			if key.Table != name {
+				continue
+			}
+			column, ok := moved(key.References)
+			if !ok {
+				return ErrForeignKey
+			}
+			if column != key.References {
+				if references[other] == nil {
+					references[other] = append([]ForeignKey(nil), referencing.foreigns...)
+				}
+				references[other][i].References = column
+			}
+		}
+	}
+	for other, foreigns := range references {
+		db.modify(other).foreigns = foreigns
+	}
+
+	return db.store(name, table)
+}
+
+//convert converts a value to the given type, nullable values can be converted to their value type as long as they are not NULL.
+func convert(value reflect.Value, to reflect.Type) (reflect.Value, error) {
+	if value.Type() == to {
+		return value, nil
+	}
+
+	if value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			if to.Kind() == reflect.Ptr {
+				return reflect.Zero(to), nil
+			}
+			return reflect.Value{}, errors.New("NULL is not a " + to.String())
+		}
+		return convert(value.Elem(), to)
+	}
+
+	if to.Kind() == reflect.Ptr {
+		converted, err := convert(value, to.Elem())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		var pointer = reflect.New(to.Elem())
+		pointer.Elem().Set(converted)
+		return pointer, nil
+	}
+
+	var numeric = func(kind reflect.Kind) bool {
+		return kind >= reflect.Int && kind <= reflect.Float64
+	}
+
+	if numeric(value.Kind()) && numeric(to.Kind()) {
+		return value.Convert(to), nil
+	}
+	if value.Kind() == to.Kind() && value.Type().ConvertibleTo(to) {
+		return value.Convert(to), nil
+	}
+
+	return reflect.Value{}, errors.New(value.Type().String() + " cannot be converted to " + to.String())
+}
+
+//fields returns the fields of the given struct type.
+func fields(rtype reflect.Type) []reflect.StructField {
+	var fields = make([]reflect.StructField, rtype.NumField())
+	for i := range fields {
+		fields[i] = rtype.Field(i)
+	}
+	return fields
+}
+
+func renameColumn(db tables, table, from, to string) error {
+	return alter(db, table, func(rtype reflect.Type) ([]reflect.StructField, map[string]string, error) {
+		if _, ok := rtype.FieldByName(from); !ok {
+			return nil, nil, errors.New("db.RenameColumn: " + table + " has no column " + from)
+		}
+		if _, ok := rtype.FieldByName(to); ok {
+			return nil, nil, errors.New("db.RenameColumn: " + table + " already has a column " + to)
+		}
+		if r, _ := utf8.DecodeRuneInString(to); !unicode.IsUpper(r) {
+			return nil, nil, errors.New("db.RenameColumn: builtin column names must start with an upper case letter: " + to)
+		}
+
+		var fields = fields(rtype)
+		for i := range fields {
+			if fields[i].Name == from {
+				fields[i].Name = to
+			}
+		}
+		return fields, map[string]string{from: to}, nil
+	})
+}
+
+func dropColumn(db tables, table, column string) error {
+	return alter(db, table, func(rtype reflect.Type) ([]reflect.StructField, map[string]string, error) {
+		if _, ok := rtype.FieldByName(column); !ok {
+			return nil, nil, errors.New("db.DropColumn: " + table + " has no column " + column)
+		}
+
+		var kept []reflect.StructField
+		for _, field := range fields(rtype) {
+			if field.Name != column {
+				kept = append(kept, field)
+			}
+		}
+		return kept, nil, nil
+	})
+}
+
+func alterColumn(db tables, table string, column Column) error {
+	return alter(db, table, func(rtype reflect.Type) ([]reflect.StructField, map[string]string, error) {
+		if _, ok := rtype.FieldByName(column.Column()); !ok {
+			return nil, nil, errors.New("db.AlterColumn: " + table + " has no column " + column.Column())
+		}
+
+		var fields = fields(rtype)
+		for i := range fields {
+			if fields[i].Name == column.Column() {
+				fields[i].Type = column.Type()
+			}
+		}
+		return fields, nil, nil
+	})
+}
+
+//RenameColumn renames a column of the named table.
+func (b Builtin) RenameColumn(table, from, to string) error {
+	return renameColumn(b, table, from, to)
+}
+
+//DropColumn removes a column, along with its values, from the named table.
+func (b Builtin) DropColumn(table, column string) error {
+	return dropColumn(b, table, column)
+}
+
+//AlterColumn changes the column of the named table with the same name as the given column to the type of the given column.
+//Existing values are converted to the new type.
+func (b Builtin) AlterColumn(table string, column Column) error {
+	return alterColumn(b, table, column)
+}
+
+//RenameColumn renames a column of the named table inside of the transaction.
+func (tx *transaction) RenameColumn(table, from, to string) error {
+	if tx.done {
+		return ErrTransactionDone
+	}
+	return renameColumn(tx, table, from, to)
+}
+
+//DropColumn removes a column, along with its values, from the named table inside of the transaction.
+func (tx *transaction) DropColumn(table, column string) error {
+	if tx.done {
+		return ErrTransactionDone
+	}
+	return dropColumn(tx, table, column)
+}
+
+//AlterColumn changes the type of a column of the named table inside of the transaction.
+func (tx *transaction) AlterColumn(table string, column Column) error {
+	if tx.done {
+		return ErrTransactionDone
+	}
+	return alterColumn(tx, table, column)
+}
@@ -0,0 +1,146 @@
+package db
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+)
+
+//names returns the names of the tables in the database.
+//The caller must hold the mutex.
+func (b Builtin) names() []string {
+	var names []string
+	for key := range database {
+		if key[0] == string(b) {
+			names = append(names, key[1])
+		}
+	}
+	return names
+}
+
+//names returns the names of the tables in the database, including the tables created by this transaction.
+//The caller must hold the mutex.
+func (tx *transaction) names() []string {
+	var names = tx.Builtin.names()
+	for name := range tx.changes {
+		if tx.Builtin.lookup(name) == nil {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+//field returns the name of the column of the table that matches the given name, ignoring case.
+func (s *storage) field(name string) (string, bool) {
+	if _, ok := s.rtype.FieldByName(name); ok {
+		return name, true
+	}
+	for i := 0; i < s.rtype.NumField(); i++ {
+		if strings.EqualFold(s.rtype.Field(i).Name, name) {
+			return s.rtype.Field(i).Name, true
+		}
+	}
+	return "", false
+}
+
+//referenced returns ErrForeignKey if the row references a row that does not exist.
+//The caller must hold the mutex.
+func referenced(db tables, table *storage, row reflect.Value) error {
+	for _, key := range table.foreigns {
+		var value = row.FieldByName(key.Column).Interface()
+
+		//NULL values do not reference anything.
+		if Null(value) {
+			continue
+		}
+
+		var target = db.lookup(key.Table)
+		if target == nil {
+			return ErrForeignKey
+		}
+
+		column, ok := target.field(key.References)
+		if !ok {
+			return ErrForeignKey
+		}
+
+		if len(target.match(column, value)) == 0 {
+			return ErrForeignKey
+		}
+	}
+	return nil
+}
+
+//cascade adds the rows that reference the deleted rows of the named table to deleted, as these are deleted along with them.
+//Returns ErrForeignKey if any of the rows are referenced by a foreign key that restricts deletion.
+//The caller must hold the mutex.
+func cascade(db tables, name string, rows []int, deleted map[string]map[int]bool) error {
+	var table = db.lookup(name)
+
+	for _, other := range db.names() {
+		var referencing = db.lookup(other)
+		if referencing == nil {
+			continue
+		}
+
+		for _, key := range referencing.foreigns {
+			if key.Table != name {
+				continue
+			}
+
+			column, ok := table.field(key.References)
+			if !ok {
+				continue
+			}
+
+			var next []int
+			for _, i := range rows {
+				for _, j := range referencing.match(key.Column, table.slice.Index(i).FieldByName(column).Interface()) {
+					if deleted[other][j] {
+						continue
+					}
+					if key.OnDelete != Cascade {
+						return ErrForeignKey
+					}
+					if deleted[other] == nil {
+						deleted[other] = make(map[int]bool)
+					}
+					deleted[other][j] = true
+					next = append(next, j)
+				}
+			}
+
+			if len(next) > 0 {
+				if err := cascade(db, other, next, deleted); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+//descending returns the given rows in descending order, so that they can be removed one by one.
+func descending(rows map[int]bool) []int {
+	var indices = make([]int, 0, len(rows))
+	for i := range rows {
+		indices = append(indices, i)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(indices)))
+	return indices
+}
+
+//remove deletes the rows at the given indices, in descending order, from the named table.
+//The caller must hold the mutex.
+func remove(db tables, name string, indices []int) {
+	var table = db.modify(name)
+
+	for _, index := range indices {
+		removeRow(table, index)
+	}
+
+	if len(indices) > 0 {
+		table.reindex()
+	}
+}
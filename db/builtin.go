@@ -1,18 +1,279 @@
 package db
 
 import (
+	"context"
 	"reflect"
+	"sort"
 	"sync"
 )
 
 type storage struct {
 	rtype reflect.Type
 	slice reflect.Value
+
+	//indexes maps the values of the key and indexed columns to the rows that hold them.
+	indexes map[string]map[interface{}][]int
+
+	//counters hold the last value that was generated for each auto column.
+	counters map[string]int64
+
+	//uniques are the unique indexes of the table.
+	uniques []Index
+
+	//foreigns are the foreign keys of the table.
+	foreigns []ForeignKey
+
+	//decimals are the decimal columns that have a fixed precision, along with their precision and scale.
+	decimals map[string][2]int
+
+	//cursors are the open cursors over the rows, they are told about the rows that are removed or moved.
+	cursors map[*cursor]struct{}
+}
+
+//clone returns a copy of the storage that can be modified independently.
+func (s *storage) clone() *storage {
+	var slice = reflect.New(s.slice.Type()).Elem()
+	slice.Set(reflect.MakeSlice(s.slice.Type(), s.slice.Len(), s.slice.Len()))
+	reflect.Copy(slice, s.slice)
+
+	var indexes = make(map[string]map[interface{}][]int, len(s.indexes))
+	for column, index := range s.indexes {
+		var copied = make(map[interface{}][]int, len(index))
+		for value, rows := range index {
+			copied[value] = append([]int(nil), rows...)
+		}
+		indexes[column] = copied
+	}
+
+	var counters = make(map[string]int64, len(s.counters))
+	for column, counter := range s.counters {
+		counters[column] = counter
+	}
+
+	return &storage{
+		rtype:    s.rtype,
+		slice:    slice,
+		indexes:  indexes,
+		counters: counters,
+		uniques:  s.uniques,
+		foreigns: s.foreigns,
+		decimals: s.decimals,
+	}
+}
+
+//fit rounds the decimals of the row to the scale of their columns.
+//Returns ErrDecimalOverflow if a decimal does not fit the precision of its column.
+func (s *storage) fit(row reflect.Value) error {
+	for column, digits := range s.decimals {
+		var field = row.FieldByName(column)
+		switch value := field.Interface().(type) {
+		case Dec:
+			rounded, err := value.round(digits[0], digits[1])
+			if err != nil {
+				return err
+			}
+			field.Set(reflect.ValueOf(rounded))
+		case *Dec:
+			if value == nil {
+				continue
+			}
+			rounded, err := value.round(digits[0], digits[1])
+			if err != nil {
+				return err
+			}
+			field.Set(reflect.ValueOf(&rounded))
+		}
+	}
+	return nil
+}
+
+//integer returns the value of the given integer.
+func integer(v reflect.Value) int64 {
+	switch v.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return int64(v.Uint())
+	default:
+		return v.Int()
+	}
+}
+
+//count advances the counter of each auto column past the value of that column in the row at the given index.
+func (s *storage) count(i int) {
+	var row = s.slice.Index(i)
+	for column, counter := range s.counters {
+		if value := integer(row.FieldByName(column)); value > counter {
+			s.counters[column] = value
+		}
+	}
+}
+
+//hashable returns a value that can be used as a map key in place of the given value.
+//Nullable values are hashed by the value they point to, with NULL values hashed as nil.
+func hashable(value interface{}) interface{} {
+	if Null(value) {
+		return nil
+	}
+	switch value := deref(value).(type) {
+	case []byte:
+		return string(value)
+	case Dec:
+		return value.canonical()
+	case Document:
+		return value.canonical()
+	default:
+		return value
+	}
+}
+
+//same returns true if the given values are equal, ie. decimals are equal if they have the same value.
+func same(a, b interface{}) bool {
+	return reflect.DeepEqual(hashable(a), hashable(b))
+}
+
+//indexRow adds the row at the given index to the indexes.
+func (s *storage) indexRow(i int) {
+	var row = s.slice.Index(i)
+	for column, index := range s.indexes {
+		var value = hashable(row.FieldByName(column).Interface())
+		index[value] = append(index[value], i)
+	}
+}
+
+//moveRow moves the row at the given index from the entries of its previous values to the entries of its current values.
+func (s *storage) moveRow(i int, previous reflect.Value) {
+	var row = s.slice.Index(i)
+	for column, index := range s.indexes {
+		var from = hashable(previous.FieldByName(column).Interface())
+		var to = hashable(row.FieldByName(column).Interface())
+		if reflect.DeepEqual(from, to) {
+			continue
+		}
+
+		var rows = index[from]
+		if n := sort.SearchInts(rows, i); n < len(rows) && rows[n] == i {
+			rows = append(rows[:n:n], rows[n+1:]...)
+		}
+		if len(rows) == 0 {
+			delete(index, from)
+		} else {
+			index[from] = rows
+		}
+
+		//The rows of each entry are kept in order.
+		rows = index[to]
+		var n = sort.SearchInts(rows, i)
+		rows = append(rows[:n:n], append([]int{i}, rows[n:]...)...)
+		index[to] = rows
+	}
+}
+
+//reindex rebuilds the indexes, it needs to be called whenever rows are moved or indexed columns are changed.
+func (s *storage) reindex() {
+	for column := range s.indexes {
+		s.indexes[column] = make(map[interface{}][]int)
+	}
+	for i := 0; i < s.slice.Len(); i++ {
+		s.indexRow(i)
+	}
+}
+
+//assign appends the rows of the given table, copying the columns that have the same name and type.
+func (s *storage) assign(from *storage) {
+	for i := 0; i < from.slice.Len(); i++ {
+		var row = reflect.New(s.rtype).Elem()
+		for j := 0; j < s.rtype.NumField(); j++ {
+			var field = s.rtype.Field(j)
+			if value := from.slice.Index(i).FieldByName(field.Name); value.IsValid() && value.Type() == field.Type {
+				row.Field(j).Set(value)
+			}
+		}
+		s.slice.Set(reflect.Append(s.slice, row))
+	}
+}
+
+//conflict returns true if a row, other than the row at index skip, has the same values as the given row
+//in the columns of a unique index.
+func (s *storage) conflict(row reflect.Value, skip int) bool {
+	for _, unique := range s.uniques {
+		if s.null(row, unique.Columns) {
+			continue
+		}
+		for _, i := range s.match(unique.Columns[0], row.FieldByName(unique.Columns[0]).Interface()) {
+			if i != skip && s.equal(s.slice.Index(i), row, unique.Columns[1:]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+//null returns true if the row is NULL in any of the given columns, these rows never conflict with each other.
+func (s *storage) null(row reflect.Value, columns []string) bool {
+	for _, column := range columns {
+		if Null(row.FieldByName(column).Interface()) {
+			return true
+		}
+	}
+	return false
+}
+
+//equal returns true if the given rows have the same values in the given columns.
+func (s *storage) equal(a, b reflect.Value, columns []string) bool {
+	for _, column := range columns {
+		if !same(a.FieldByName(column).Interface(), b.FieldByName(column).Interface()) {
+			return false
+		}
+	}
+	return true
+}
+
+//keyed returns the row that has the same values as the insertion in all of its key columns.
+func (s *storage) keyed(in *Insertion) (int, bool) {
+	var keys []int
+	for i := range in.Columns {
+		if in.Uniques[i] {
+			keys = append(keys, i)
+		}
+	}
+	if len(keys) == 0 {
+		return 0, false
+	}
+
+	var first = keys[0]
+	for _, row := range s.match(in.Columns[first], in.Values[first]) {
+		var equal = true
+		for _, k := range keys[1:] {
+			if !same(s.slice.Index(row).FieldByName(in.Columns[k]).Interface(), in.Values[k]) {
+				equal = false
+				break
+			}
+		}
+		if equal {
+			return row, true
+		}
+	}
+	return 0, false
+}
+
+//find returns the rows where the given column is equal to the given value.
+//Returns false if the column is not indexed.
+func (s *storage) find(column string, value interface{}) ([]int, bool) {
+	index, ok := s.indexes[column]
+	if !ok {
+		return nil, false
+	}
+	return index[hashable(value)], true
 }
 
 var database = make(map[[2]string]*storage)
 var mutex sync.RWMutex
 
+//versions count the writes to each table, so that transactions can detect writes made outside of them.
+var versions = make(map[[2]string]uint64)
+
+//locks are the named locks held with Builtin.Lock
+var locks = make(map[[2]string]chan struct{})
+
 func index(database Builtin, table string) [2]string {
 	return [2]string{
 		string(database),
@@ -20,9 +281,85 @@ func index(database Builtin, table string) [2]string {
 	}
 }
 
+//tables is implemented by Builtin and its transactions.
+//The caller must hold the mutex.
+type tables interface {
+	Driver
+
+	//lookup returns the named table for reading.
+	lookup(name string) *storage
+
+	//modify returns the named table for writing.
+	modify(name string) *storage
+
+	//store replaces the named table, nil deletes the table.
+	store(name string, table *storage) error
+
+	//swap replaces the named table in memory, without persisting it.
+	swap(name string, table *storage)
+
+	//load reads the persisted rows of the named table into the given table.
+	//Returns true if the rows have been journaled since their last snapshot.
+	load(name string, table *storage) (bool, error)
+
+	//journal records an operation on the named table, if this fails the operation is not made or is undone.
+	//Indices are the rows that are updated or deleted, rows are the inserted rows or the updated values of the rows at indices.
+	journal(name, operation string, indices []int, rows ...reflect.Value) error
+
+	//names returns the names of the tables.
+	names() []string
+}
+
 //Builtin is a builtin database.
 type Builtin string
 
+func (b Builtin) lookup(name string) *storage {
+	return database[index(b, name)]
+}
+
+func (b Builtin) modify(name string) *storage {
+	versions[index(b, name)]++
+	return database[index(b, name)]
+}
+
+func (b Builtin) swap(name string, table *storage) {
+	versions[index(b, name)]++
+
+	if table == nil {
+		delete(database, index(b, name))
+	} else {
+		database[index(b, name)] = table
+	}
+}
+
+func (b Builtin) store(name string, table *storage) error {
+	b.swap(name, table)
+
+	if p, ok := roots[b]; ok {
+		return p.save(name, table)
+	}
+	return nil
+}
+
+//replace stores each of the given tables, either all of them are replaced or none of them are.
+//The caller must hold the mutex.
+func (b Builtin) replace(tables map[string]*storage) error {
+	var previous = make(map[string]*storage, len(tables))
+	for name := range tables {
+		previous[name] = b.lookup(name)
+	}
+
+	for name, table := range tables {
+		if err := b.store(name, table); err != nil {
+			for name, table := range previous {
+				b.store(name, table)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
 func (b Builtin) connect(v Viewer) {
 	Connect(v, b)
 }
@@ -37,116 +374,394 @@ func (b Builtin) Connect(first Viewer, more ...Viewer) Driver {
 	return b
 }
 
-//Sync syncs the Tables with the Database, adding any missing columns.
-//If constraints or types do not match up, an error is returned.
-func (b Builtin) Sync(table Table, tables ...Table) error {
+//planTable returns the differences between the table and its storage.
+//The caller must hold the mutex.
+func planTable(db tables, table Table) Diff {
+	var existing = db.lookup(table.Table())
+	if existing == nil {
+		return Diff{Changes: []Change{{Difference: MissingTable, Table: table.Table()}}}
+	}
 
-	sync := func(table Table) error {
-		//Need to create a struct that represents this table.
-		var fields = make([]reflect.StructField, table.Columns())
+	var diff Diff
+	for i := 0; i < table.Columns(); i++ {
+		column := table.Column(i)
+
+		field, ok := existing.rtype.FieldByName(column.Column())
+		if !ok {
+			diff.Changes = append(diff.Changes, Change{
+				Difference: MissingColumn,
+				Table:      table.Table(),
+				Column:     column.Column(),
+			})
+			continue
+		}
+		if field.Type != column.Type() {
+			diff.Changes = append(diff.Changes, Change{
+				Difference: TypeMismatch,
+				Table:      table.Table(),
+				Column:     column.Column(),
+				Want:       column.Type().String(),
+				Have:       field.Type.String(),
+			})
+		}
+	}
+
+	for i := 0; i < existing.rtype.NumField(); i++ {
+		name := existing.rtype.Field(i).Name
+		if _, ok := find(table, name); !ok {
+			diff.Changes = append(diff.Changes, Change{
+				Difference: ExtraColumn,
+				Table:      table.Table(),
+				Column:     name,
+			})
+		}
+	}
+
+	return diff
+}
+
+func syncTable(db tables, table Table) error {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if err := planTable(db, table).Err(); err != nil {
+		return err
+	}
 
-		for i := 0; i < table.Columns(); i++ {
-			column := table.Column(i)
+	//Need to create a struct that represents this table.
+	var fields = make([]reflect.StructField, table.Columns())
 
-			fields[i] = reflect.StructField{
-				Name: column.Column(),
-				Type: column.Type(),
+	var indexes = make(map[string]map[interface{}][]int)
+	var counters = make(map[string]int64)
+	var decimals = make(map[string][2]int)
+
+	for i := 0; i < table.Columns(); i++ {
+		column := table.Column(i)
+
+		fields[i] = reflect.StructField{
+			Name: column.Column(),
+			Type: column.Type(),
+		}
+
+		indexed, ok := column.(interface{ Indexed() bool })
+		if column.Key() || (ok && indexed.Indexed()) {
+			indexes[column.Column()] = make(map[interface{}][]int)
+		}
+
+		if auto, ok := column.(interface{ Auto() bool }); ok && auto.Auto() {
+			counters[column.Column()] = 0
+		}
+
+		if p, ok := column.(interface{ Precision() int }); ok && p.Precision() > 0 {
+			var scale int
+			if s, ok := column.(interface{ Scale() int }); ok {
+				scale = s.Scale()
 			}
+			decimals[column.Column()] = [2]int{p.Precision(), scale}
 		}
+	}
 
-		database[index(b, table.Table())] = &storage{
-			rtype: reflect.StructOf(fields),
-			slice: reflect.New(reflect.SliceOf(reflect.StructOf(fields))).Elem(),
+	var uniques []Index
+	for _, index := range Indexes(table) {
+		if index.Unique {
+			uniques = append(uniques, index)
 		}
+	}
 
-		return nil
+	var storage = &storage{
+		rtype: reflect.StructOf(fields),
+		slice: reflect.New(reflect.SliceOf(reflect.StructOf(fields))).Elem(),
+
+		indexes:  indexes,
+		counters: counters,
+		uniques:  uniques,
+		foreigns: ForeignKeys(table),
+		decimals: decimals,
+	}
+
+	journaled, err := db.load(table.Table(), storage)
+	if err != nil {
+		return err
+	}
+
+	//Keep the rows of a table that already exists.
+	var assigned bool
+	if existing := db.lookup(table.Table()); existing != nil && storage.slice.Len() == 0 {
+		storage.assign(existing)
+		assigned = storage.slice.Len() > 0
 	}
 
-	if err := sync(table); err != nil {
+	storage.reindex()
+	for i := 0; i < storage.slice.Len(); i++ {
+		storage.count(i)
+	}
+
+	//The snapshot is only rewritten if it is missing rows.
+	if assigned || journaled {
+		return db.store(table.Table(), storage)
+	}
+
+	db.swap(table.Table(), storage)
+	return nil
+}
+
+//Sync syncs the Tables with the Database, adding any missing columns.
+//If constraints or types do not match up, an error is returned.
+func (b Builtin) Sync(table Table, tables ...Table) error {
+	if err := syncTable(b, table); err != nil {
 		return err
 	}
 	for _, table := range tables {
-		if err := sync(table); err != nil {
+		if err := syncTable(b, table); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (b Builtin) insert(row Row) error {
+//Plan returns the changes that Sync would make to the database, without making them.
+func (b Builtin) Plan(table Table, tables ...Table) (Diff, error) {
+	mutex.RLock()
+	defer mutex.RUnlock()
+
+	var diff = planTable(b, table)
+	for _, table := range tables {
+		diff.Changes = append(diff.Changes, planTable(b, table).Changes...)
+	}
+	return diff, nil
+}
+
+//match returns the rows where the given column is equal to the given value.
+func (s *storage) match(column string, value interface{}) []int {
+	if rows, ok := s.find(column, value); ok {
+		return rows
+	}
+
+	var rows []int
+	for i := 0; i < s.slice.Len(); i++ {
+		if same(s.slice.Index(i).FieldByName(column).Interface(), value) {
+			rows = append(rows, i)
+		}
+	}
+	return rows
+}
+
+//putRow inserts the given row into the database.
+//If upsert is true, the given columns of an existing row with the same key are overwritten instead.
+func putRow(ctx context.Context, db tables, row Row, upsert bool, columns []string) error {
 	mutex.Lock()
 	defer mutex.Unlock()
 
+	return put(ctx, db, row, upsert, columns)
+}
+
+//put is putRow without locking, the caller must hold the mutex.
+func put(ctx context.Context, db tables, row Row, upsert bool, columns []string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	var in Insertion
-	in.Row(row)
+	if err := in.Row(row); err != nil {
+		return err
+	}
 
-	var table = database[index(b, row.Row().Table())]
+	var table = db.modify(row.Row().Table())
 
 	if table == nil {
 		return ErrTableNotFound
 	}
 
-	var structure = reflect.New(table.rtype).Elem()
-
 	for i, column := range in.Columns {
+		if counter, ok := table.counters[column]; ok && in.Autos[i] {
+			in.Generated(i, counter+1)
+		}
+	}
 
-		if in.Uniques[i] {
+	//Check if the key is taken. If, so reject this insert or update the existing row.
+	if existing, ok := table.keyed(&in); ok {
+		if !upsert {
+			return ErrDuplicateKey
+		}
 
-			//Check if the unique value is taken. If, so reject this insert.
-			for i := 0; i < table.slice.Len(); i++ {
-				row := table.slice.Index(i)
+		var row = table.slice.Index(existing)
 
-				if reflect.DeepEqual(row.FieldByName(column).Interface(), in.Values[i]) {
-					return ErrDuplicateKey
-				}
-			}
+		var previous = reflect.New(table.rtype).Elem()
+		previous.Set(row)
+
+		restore := func() {
+			var updated = reflect.New(table.rtype).Elem()
+			updated.Set(row)
+			row.Set(previous)
+			table.moveRow(existing, updated)
 		}
 
+		for _, j := range in.Overwrites(columns) {
+			row.FieldByName(in.Columns[j]).Set(reflect.ValueOf(in.Values[j]))
+		}
+		if err := table.fit(row); err != nil {
+			row.Set(previous)
+			return err
+		}
+		table.moveRow(existing, previous)
+
+		if table.conflict(row, existing) {
+			restore()
+			return ErrDuplicateKey
+		}
+		if err := referenced(db, table, row); err != nil {
+			restore()
+			return err
+		}
+		if err := db.journal(in.Table.Table(), opUpdate, []int{existing}, row); err != nil {
+			restore()
+			return err
+		}
+
+		return nil
+	}
+
+	var structure = reflect.New(table.rtype).Elem()
+
+	for i, column := range in.Columns {
 		structure.FieldByName(column).Set(reflect.ValueOf(in.Values[i]))
 	}
+	if err := table.fit(structure); err != nil {
+		return err
+	}
+
+	if table.conflict(structure, -1) {
+		return ErrDuplicateKey
+	}
+	if err := referenced(db, table, structure); err != nil {
+		return err
+	}
+
+	if err := db.journal(row.Row().Table(), opInsert, nil, structure); err != nil {
+		return err
+	}
 
 	table.slice.Set(reflect.Append(table.slice, structure))
+	table.indexRow(table.slice.Len() - 1)
+	table.count(table.slice.Len() - 1)
 
 	return nil
 }
 
+func insertRow(ctx context.Context, db tables, row Row) error {
+	return putRow(ctx, db, row, false, nil)
+}
+
 //Insert inserts the given row into the database.
 func (b Builtin) Insert(row Row, rows ...Row) error {
+	return b.InsertContext(context.Background(), row, rows...)
+}
+
+//InsertContext inserts the given row into the database with the given context.
+//Multiple rows are inserted atomically, either all of them are inserted or none of them are.
+func (b Builtin) InsertContext(ctx context.Context, row Row, rows ...Row) error {
+	if len(rows) == 0 {
+		if row != nil {
+			return insertRow(ctx, b, row)
+		}
+		return nil
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	//The rows are inserted into copies of the tables, which replace the tables once all rows have been inserted.
+	var tx = transaction{
+		Builtin: b,
+		changes: make(map[string]*storage),
+	}
+
 	if row != nil {
-		if err := b.insert(row); err != nil {
+		if err := put(ctx, &tx, row, false, nil); err != nil {
 			return err
 		}
 	}
 	for _, row := range rows {
-		if err := b.insert(row); err != nil {
+		if err := put(ctx, &tx, row, false, nil); err != nil {
 			return err
 		}
 	}
-	return nil
+
+	return b.replace(tx.changes)
+}
+
+//UpsertContext inserts the given row into the database with the given context.
+//If a row with the same key already exists, the given columns of that row are overwritten instead (all columns if none are given).
+//Multiple rows are upserted atomically, either all of them are upserted or none of them are.
+func (b Builtin) UpsertContext(ctx context.Context, columns []string, row Row, rows ...Row) error {
+	if len(rows) == 0 {
+		if row != nil {
+			return putRow(ctx, b, row, true, columns)
+		}
+		return nil
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	//The rows are upserted into copies of the tables, which replace the tables once all rows have been upserted.
+	var tx = transaction{
+		Builtin: b,
+		changes: make(map[string]*storage),
+	}
+
+	if row != nil {
+		if err := put(ctx, &tx, row, true, columns); err != nil {
+			return err
+		}
+	}
+	for _, row := range rows {
+		if err := put(ctx, &tx, row, true, columns); err != nil {
+			return err
+		}
+	}
+
+	return b.replace(tx.changes)
+}
+
+func deleteTable(db tables, table Table) error {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	return db.store(table.Table(), nil)
 }
 
 //Delete deletes the given tables.
 func (b Builtin) Delete(table Table, tables ...Table) error {
 	if table != nil {
-		delete(database, index(b, table.Table()))
+		if err := deleteTable(b, table); err != nil {
+			return err
+		}
 	}
 	for _, table := range tables {
-		delete(database, index(b, table.Table()))
+		if err := deleteTable(b, table); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
-func (b Builtin) empty(t Table) error {
+func emptyTable(db tables, t Table) error {
 	mutex.Lock()
 	defer mutex.Unlock()
 
-	var table = database[index(b, t.Table())]
+	var table = db.modify(t.Table())
 
 	if table == nil {
 		return ErrTableNotFound
 	}
 
-	table.slice.Set(reflect.Zero(table.slice.Type()))
+	if err := db.journal(t.Table(), opEmpty, nil); err != nil {
+		return err
+	}
+
+	table.empty()
+	table.reindex()
 
 	return nil
 }
@@ -154,18 +769,59 @@ func (b Builtin) empty(t Table) error {
 //Empty removes all rows from the given tables so that they are empty.
 func (b Builtin) Empty(table Table, tables ...Table) error {
 	if table != nil {
-		if err := b.empty(table); err != nil {
+		if err := emptyTable(b, table); err != nil {
 			return err
 		}
 	}
 	for _, table := range tables {
-		if err := b.empty(table); err != nil {
+		if err := emptyTable(b, table); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+//Begin starts a copy-on-write transaction.
+//Tables are copied the first time they are written to inside of the transaction.
+//On commit, these copies replace the tables of the database.
+//If any table that the transaction used has been written to outside of the transaction since, the commit fails with ErrTransactionConflict.
+func (b Builtin) Begin() (Tx, error) {
+	return &transaction{
+		Builtin:  b,
+		changes:  make(map[string]*storage),
+		versions: make(map[string]uint64),
+	}, nil
+}
+
+//BeginContext starts a copy-on-write transaction, unless the given context is done.
+func (b Builtin) BeginContext(ctx context.Context) (Tx, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return b.Begin()
+}
+
+//Lock blocks until the named lock of the database is acquired, the returned function releases it.
+func (b Builtin) Lock(ctx context.Context, name string) (func() error, error) {
+	mutex.Lock()
+	lock, ok := locks[index(b, name)]
+	if !ok {
+		lock = make(chan struct{}, 1)
+		locks[index(b, name)] = lock
+	}
+	mutex.Unlock()
+
+	select {
+	case lock <- struct{}{}:
+		return func() error {
+			<-lock
+			return nil
+		}, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
 //Close closes the connection to the database.
 func (Builtin) Close() error {
 	return nil
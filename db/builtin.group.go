@@ -0,0 +1,210 @@
+package db
+
+import (
+	"errors"
+	"math/big"
+	"reflect"
+	"sort"
+)
+
+//group splits the results into groups of rows that have the same values in the grouped columns.
+//The groups are ordered by the values of the grouped columns.
+func (s selection) group(table *storage, results []int) [][]int {
+	if len(s.groups) == 0 {
+		return [][]int{results}
+	}
+
+	var sorters = make([]Sorter, len(s.groups))
+	for i, group := range s.groups {
+		sorters[i] = Sorter{Table: group.Table(), Column: group.Column()}
+	}
+
+	sort.Sort(sortable{
+		indicies: results,
+		table:    table,
+		sorter:   sorters[0],
+		sorters:  sorters[1:],
+	})
+
+	same := func(i, j int) bool {
+		for _, group := range s.groups {
+			var a = table.slice.Index(i).FieldByName(group.Column()).Interface()
+			var b = table.slice.Index(j).FieldByName(group.Column()).Interface()
+			if !same(a, b) {
+				return false
+			}
+		}
+		return true
+	}
+
+	var groups [][]int
+	for i, index := range results {
+		if i == 0 || !same(results[i-1], index) {
+			groups = append(groups, nil)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], index)
+	}
+	return groups
+}
+
+//aggregate returns the result of the aggregate function on the given rows.
+func aggregate(table *storage, a Aggregate, rows []int) (reflect.Value, error) {
+	if a.Function == FnCount {
+		return reflect.ValueOf(int64(len(rows))), nil
+	}
+
+	var values []reflect.Value
+	for _, index := range rows {
+		var value = table.slice.Index(index).FieldByName(a.Column.Column())
+
+		//NULL values are left out of aggregates, as they are in SQL.
+		if value.Kind() == reflect.Ptr {
+			if value.IsNil() {
+				continue
+			}
+			value = value.Elem()
+		}
+
+		values = append(values, value)
+	}
+
+	//Only NULL values.
+	if len(values) == 0 {
+		return reflect.Value{}, nil
+	}
+
+	switch a.Function {
+	case FnMin, FnMax:
+		var result = values[0]
+		for _, value := range values[1:] {
+			if a.Function == FnMax {
+				if compare(result.Interface(), value.Interface()) {
+					result = value
+				}
+			} else if compare(value.Interface(), result.Interface()) {
+				result = value
+			}
+		}
+		return result, nil
+
+	case FnSum, FnAverage:
+		if _, ok := values[0].Interface().(Dec); ok {
+			return aggregateDecimals(a, values)
+		}
+
+		var sum = reflect.New(values[0].Type()).Elem()
+		var avg float64
+		for _, value := range values {
+			switch value.Kind() {
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+				sum.SetInt(sum.Int() + value.Int())
+				avg += float64(value.Int())
+			case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+				sum.SetUint(sum.Uint() + value.Uint())
+				avg += float64(value.Uint())
+			case reflect.Float32, reflect.Float64:
+				sum.SetFloat(sum.Float() + value.Float())
+				avg += value.Float()
+			default:
+				return reflect.Value{}, errors.New("cannot aggregate type: " + value.Type().String())
+			}
+		}
+		if a.Function == FnAverage {
+			return reflect.ValueOf(avg / float64(len(values))), nil
+		}
+		return sum, nil
+
+	default:
+		return reflect.Value{}, errors.New("unsupported aggregate function")
+	}
+}
+
+//aggregateDecimals returns the exact sum or average of the given decimal values.
+func aggregateDecimals(a Aggregate, values []reflect.Value) (reflect.Value, error) {
+	var sum = new(big.Rat)
+	for _, value := range values {
+		r, err := value.Interface().(Dec).Rat()
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		sum.Add(sum, r)
+	}
+	if a.Function == FnAverage {
+		avg, _ := sum.Quo(sum, new(big.Rat).SetInt64(int64(len(values)))).Float64()
+		return reflect.ValueOf(avg), nil
+	}
+	return reflect.ValueOf(NewDec(sum)), nil
+}
+
+//assign sets the variable that the given pointer points to to the given value, converting it if needed.
+//An invalid value is NULL and sets the variable to its zero value.
+func assign(variable interface{}, value reflect.Value) {
+	var target = reflect.ValueOf(variable).Elem()
+	if !value.IsValid() {
+		target.Set(reflect.Zero(target.Type()))
+		return
+	}
+
+	//Nullable variables point to their value.
+	if target.Kind() == reflect.Ptr && value.Kind() != reflect.Ptr {
+		var pointer = reflect.New(target.Type().Elem())
+		assign(pointer.Interface(), value)
+		target.Set(pointer)
+		return
+	}
+	if value.Kind() == reflect.Ptr && target.Kind() != reflect.Ptr {
+		assign(variable, reflect.Indirect(value))
+		return
+	}
+	if value.Type() != target.Type() {
+		value = value.Convert(target.Type())
+	}
+	target.Set(clone(value))
+}
+
+//Aggregate reads the grouped columns and the aggregates of each group of the selected rows into their variables.
+func (s selection) Aggregate(a Aggregate, as ...Aggregate) (int, error) {
+	mutex.RLock()
+	defer mutex.RUnlock()
+
+	var table = s.db.lookup(s.table)
+
+	if table == nil {
+		return 0, ErrTableNotFound
+	}
+
+	results, err := s.query(table)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(results) == 0 {
+		return 0, ErrNotFound
+	}
+
+	var aggregates = append([]Aggregate{a}, as...)
+
+	var groups = s.group(table, results)
+
+	for _, group := range s.groups {
+		group.Make(len(groups))
+	}
+	for _, a := range aggregates {
+		a.Into.Make(len(groups))
+	}
+
+	for i, rows := range groups {
+		for _, group := range s.groups {
+			assign(group.Slice(i), table.slice.Index(rows[0]).FieldByName(group.Column()))
+		}
+		for _, a := range aggregates {
+			result, err := aggregate(table, a, rows)
+			if err != nil {
+				return 0, err
+			}
+			assign(a.Into.Slice(i), result)
+		}
+	}
+
+	return len(groups), nil
+}
@@ -0,0 +1,319 @@
+package db
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"qlova.store/fs"
+)
+
+//persistence is where a persistent Builtin database stores its tables.
+type persistence struct {
+	root fs.Root
+
+	//sequence holds the number of the last journal entry of each table.
+	sequence map[string]int
+}
+
+var roots = make(map[Builtin]*persistence)
+
+//Persist returns the builtin database with the given name and persists its tables to the given root.
+//Each table is stored as a snapshot and an append-only journal of the inserts, updates and deletes made since the snapshot.
+//Tables are reloaded from the root when they are synced, as long as their columns still have the same types.
+func Persist(name string, root fs.Root) Builtin {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	roots[Builtin(name)] = &persistence{
+		root:     root,
+		sequence: make(map[string]int),
+	}
+
+	return Builtin(name)
+}
+
+//load reads the named table from the root of a persistent database into the given table.
+func (b Builtin) load(name string, table *storage) (bool, error) {
+	if p, ok := roots[b]; ok {
+		return p.load(name, table)
+	}
+	return false, nil
+}
+
+//journal records the given operation on the named table.
+//Indices are the rows that are updated or deleted, rows are the inserted rows or the updated values of the rows at indices.
+func (b Builtin) journal(name, operation string, indices []int, rows ...reflect.Value) error {
+	p, ok := roots[b]
+	if !ok || (operation != opInsert && operation != opEmpty && len(indices) == 0) {
+		return nil
+	}
+
+	var c = change{Operation: operation}
+
+	switch operation {
+	case opInsert, opUpdate:
+		encoded, err := encodeRows(rows)
+		if err != nil {
+			return err
+		}
+		c.Rows = encoded
+		if operation == opUpdate {
+			c.Indices = indices
+		}
+	case opDelete:
+		c.Indices = indices
+	}
+
+	return p.append(name, c)
+}
+
+//snapshot is the persisted state of a table.
+type snapshot struct {
+	//Sequence is the number of the last journal entry that is included in the snapshot.
+	Sequence int
+
+	Rows json.RawMessage
+}
+
+//change is an entry in the journal of a table.
+type change struct {
+	Operation string
+
+	//Indices of the rows that were updated or deleted, in the order they were changed.
+	Indices []int `json:",omitempty"`
+
+	//Rows that were inserted or the updated values of the rows at Indices.
+	Rows []json.RawMessage `json:",omitempty"`
+}
+
+//Journal operations.
+const (
+	opInsert = "insert"
+	opUpdate = "update"
+	opDelete = "delete"
+	opEmpty  = "empty"
+)
+
+func (p *persistence) directory(table string) fs.Directory {
+	return p.root.Goto(fs.Path(table))
+}
+
+func (p *persistence) entry(table string, sequence int) fs.File {
+	return p.directory(table).Goto("journal").File(fmt.Sprintf("%08d.json", sequence))
+}
+
+//load reads the table with the given name from the root into the given storage.
+//Returns true if the journal of the table has any entries.
+func (p *persistence) load(name string, table *storage) (bool, error) {
+	var directory = p.directory(name)
+	var file = directory.File("snapshot.json")
+
+	var snap snapshot
+
+	if _, err := file.Stat(); err == nil {
+		var buffer bytes.Buffer
+		if _, err := file.WriteTo(&buffer); err != nil {
+			return false, err
+		}
+		if err := json.Unmarshal(buffer.Bytes(), &snap); err != nil {
+			return false, fmt.Errorf("db.Persist: corrupt snapshot of %v: %w", name, err)
+		}
+
+		if len(snap.Rows) > 0 {
+			var rows = reflect.New(table.slice.Type())
+			if err := json.Unmarshal(snap.Rows, rows.Interface()); err != nil {
+				return false, fmt.Errorf("db.Persist: corrupt snapshot of %v: %w", name, err)
+			}
+			table.slice.Set(rows.Elem())
+		}
+	}
+
+	//Replay the journal.
+	var sequence = snap.Sequence
+	for {
+		var file = p.entry(name, sequence+1)
+		if _, err := file.Stat(); err != nil {
+			break
+		}
+
+		var buffer bytes.Buffer
+		if _, err := file.WriteTo(&buffer); err != nil {
+			return false, err
+		}
+
+		var c change
+		if err := json.Unmarshal(buffer.Bytes(), &c); err != nil {
+			return false, fmt.Errorf("db.Persist: corrupt journal of %v: %w", name, err)
+		}
+
+		if err := c.apply(table); err != nil {
+			return false, fmt.Errorf("db.Persist: corrupt journal of %v: %w", name, err)
+		}
+
+		sequence++
+	}
+
+	p.sequence[name] = sequence
+
+	return sequence > snap.Sequence, nil
+}
+
+//save writes a snapshot of the given table to the root and then discards its journal.
+//A nil table removes the table from the root.
+func (p *persistence) save(name string, table *storage) error {
+	var directory = p.directory(name)
+
+	if table == nil {
+		delete(p.sequence, name)
+		return directory.Delete()
+	}
+
+	rows, err := json.Marshal(table.slice.Interface())
+	if err != nil {
+		return err
+	}
+
+	encoded, err := json.Marshal(snapshot{
+		Sequence: p.sequence[name],
+		Rows:     rows,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := directory.Create(); err != nil {
+		return err
+	}
+
+	//The snapshot is written to a temporary file that then replaces the previous snapshot,
+	//so that a failed write never leaves the table without a snapshot.
+	//Data that cannot be renamed, such as S3 objects, is replaced at once when it is written.
+	var temporary = directory.File("snapshot.json.tmp")
+	if _, ok := temporary.Data.(fs.Renamer); !ok {
+		temporary = directory.File("snapshot.json")
+	}
+
+	if err := temporary.SetString(string(encoded)); err != nil {
+		return err
+	}
+
+	if renamer, ok := temporary.Data.(fs.Renamer); ok {
+		if err := renamer.Rename("snapshot.json"); err != nil {
+			return err
+		}
+	}
+
+	//Journal entries that are older than the snapshot are ignored, so a failure here is harmless.
+	directory.Goto("journal").Delete()
+
+	return nil
+}
+
+//append writes the given change to the end of the journal of the named table.
+func (p *persistence) append(name string, c change) error {
+	encoded, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+
+	var journal = p.directory(name).Goto("journal")
+	if err := journal.Create(); err != nil {
+		return err
+	}
+
+	if err := p.entry(name, p.sequence[name]+1).SetString(string(encoded)); err != nil {
+		return err
+	}
+
+	p.sequence[name]++
+
+	return nil
+}
+
+//apply applies the change to the given table.
+func (c change) apply(table *storage) error {
+	decode := func(data json.RawMessage) (reflect.Value, error) {
+		var row = reflect.New(table.rtype)
+		if err := json.Unmarshal(data, row.Interface()); err != nil {
+			return reflect.Value{}, err
+		}
+		return row.Elem(), nil
+	}
+
+	switch c.Operation {
+	case opInsert:
+		for _, data := range c.Rows {
+			row, err := decode(data)
+			if err != nil {
+				return err
+			}
+			table.slice.Set(reflect.Append(table.slice, row))
+		}
+
+	case opUpdate:
+		if len(c.Indices) != len(c.Rows) {
+			return fmt.Errorf("invalid update")
+		}
+		for i, index := range c.Indices {
+			if index < 0 || index >= table.slice.Len() {
+				return fmt.Errorf("invalid index %v", index)
+			}
+			row, err := decode(c.Rows[i])
+			if err != nil {
+				return err
+			}
+			table.slice.Index(index).Set(row)
+		}
+
+	case opDelete:
+		for _, index := range c.Indices {
+			if index < 0 || index >= table.slice.Len() {
+				return fmt.Errorf("invalid index %v", index)
+			}
+			removeRow(table, index)
+		}
+
+	case opEmpty:
+		table.empty()
+
+	default:
+		return fmt.Errorf("unknown operation %q", c.Operation)
+	}
+
+	return nil
+}
+
+//removeRow removes the row at the given index by replacing it with the last row.
+func removeRow(table *storage, index int) {
+	var last = table.slice.Len() - 1
+	table.slice.Index(index).Set(table.slice.Index(last))
+	table.slice.Set(table.slice.Slice(0, last))
+
+	for c := range table.cursors {
+		c.removed(index, last)
+	}
+}
+
+//empty removes all of the rows.
+func (s *storage) empty() {
+	s.slice.Set(reflect.Zero(s.slice.Type()))
+
+	for c := range s.cursors {
+		c.pending = nil
+	}
+}
+
+//encodeRows returns the JSON encoding of the given rows.
+func encodeRows(rows []reflect.Value) ([]json.RawMessage, error) {
+	var encoded = make([]json.RawMessage, len(rows))
+	for i, row := range rows {
+		data, err := json.Marshal(row.Interface())
+		if err != nil {
+			return nil, err
+		}
+		encoded[i] = data
+	}
+	return encoded, nil
+}
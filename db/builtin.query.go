@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"reflect"
 	"sort"
+	"time"
 )
 
 type sortable struct {
@@ -23,7 +24,19 @@ func (s sortable) Len() int {
 	return len(s.indicies)
 }
 
-func (s sortable) compare(a, b interface{}) bool {
+//compare returns true if a is less than b.
+//NULL values are larger than any other value, as they are in postgres.
+func compare(a, b interface{}) bool {
+	if x, y := reflect.ValueOf(a), reflect.ValueOf(b); x.Kind() == reflect.Ptr {
+		switch {
+		case x.IsNil():
+			return false
+		case y.IsNil():
+			return true
+		}
+		return compare(x.Elem().Interface(), y.Elem().Interface())
+	}
+
 	switch a.(type) {
 	case uint:
 		return a.(uint) < b.(uint)
@@ -56,50 +69,90 @@ func (s sortable) compare(a, b interface{}) bool {
 		return a.(string) < b.(string)
 
 	case bool:
-		return a == false
+		return !a.(bool) && b.(bool)
 
 	case []byte:
 		return bytes.Compare(a.([]byte), b.([]byte)) == -1
 
+	case time.Time:
+		return a.(time.Time).Before(b.(time.Time))
+
+	case uid:
+		x, y := a.(uid), b.(uid)
+		return bytes.Compare(x[:], y[:]) == -1
+
+	case Dec:
+		x, errx := a.(Dec).Rat()
+		y, erry := b.(Dec).Rat()
+		if errx != nil || erry != nil {
+			return a.(Dec) < b.(Dec)
+		}
+		return x.Cmp(y) < 0
+
+	case Document:
+		var x, y interface{}
+		if a.(Document).Decode(&x) != nil || b.(Document).Decode(&y) != nil {
+			return a.(Document) < b.(Document)
+		}
+		return orderJSON(x, y) < 0
+
 	}
 	panic("unsortable type: " + reflect.TypeOf(a).String())
 }
 
 func (s sortable) Less(i, j int) bool {
-	a := s.table.slice.Index(i)
-	b := s.table.slice.Index(j)
-
-	less := s.compare(a.FieldByName(s.sorter.Column).Interface(), b.FieldByName(s.sorter.Column).Interface())
-	if s.sorter.Decreasing {
-		less = !less
-	}
+	a := s.table.slice.Index(s.indicies[i])
+	b := s.table.slice.Index(s.indicies[j])
 
-	if !less {
-		return false
-	}
-
-	for _, sorter := range s.sorters {
-		less = s.compare(a.FieldByName(sorter.Column).Interface(), b.FieldByName(sorter.Column).Interface())
+	//Later sorters are only used to break ties.
+	for _, sorter := range append([]Sorter{s.sorter}, s.sorters...) {
+		x, y := a.FieldByName(sorter.Column).Interface(), b.FieldByName(sorter.Column).Interface()
 		if sorter.Decreasing {
-			less = !less
+			x, y = y, x
 		}
 
-		if !less {
+		if compare(x, y) {
+			return true
+		}
+		if compare(y, x) {
 			return false
 		}
 	}
 
-	return true
+	return false
 }
 
 func (s sortable) Swap(i, j int) {
 	s.indicies[i], s.indicies[j] = s.indicies[j], s.indicies[i]
 }
 
-func (s selection) query(table *storage) []int {
+func (s selection) query(table *storage) ([]int, error) {
 	var results []int
 
-	for i := 0; i < table.slice.Len(); i++ {
+	//Only the rows found in an index need to be checked.
+	var candidates []int
+	var indexed bool
+	for _, lookup := range s.lookups {
+		if candidates, indexed = table.find(lookup.Column, lookup.Value); indexed {
+			break
+		}
+	}
+
+	var length = table.slice.Len()
+	if indexed {
+		length = len(candidates)
+	}
+
+	for n := 0; n < length; n++ {
+		if err := s.ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		var i = n
+		if indexed {
+			i = candidates[n]
+		}
+
 		row := table.slice.Index(i)
 
 		var matches bool = true
@@ -127,5 +180,20 @@ func (s selection) query(table *storage) []int {
 		})
 	}
 
+	return results, nil
+}
+
+//window returns the results that are within the offset and length of the selection.
+//A length of zero includes all results after the offset.
+func (s selection) window(results []int) []int {
+	if s.offset > 0 {
+		if s.offset >= len(results) {
+			return nil
+		}
+		results = results[s.offset:]
+	}
+	if s.length > 0 && s.length < len(results) {
+		results = results[:s.length]
+	}
 	return results
 }
@@ -0,0 +1,127 @@
+package db
+
+import "reflect"
+
+//cursor walks over the results of a selection on a builtin table.
+//Only the indices of the results are kept, each row is copied when the cursor advances to it,
+//the table keeps the indices up to date when rows are removed during iteration.
+type cursor struct {
+	selection
+
+	//table holds the rows of the results.
+	table *storage
+
+	//pending are the indices of the rows that have not been read yet.
+	pending []int
+
+	//row is a copy of the current row.
+	row reflect.Value
+
+	err error
+}
+
+//Rows returns a cursor that reads the results one row at a time.
+func (s selection) Rows() (Rows, error) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	var table = s.db.lookup(s.table)
+
+	if table == nil {
+		return nil, ErrTableNotFound
+	}
+
+	results, err := s.query(table)
+	if err != nil {
+		return nil, err
+	}
+
+	var c = &cursor{
+		selection: s,
+		table:     table,
+		pending:   s.window(results),
+	}
+
+	if table.cursors == nil {
+		table.cursors = make(map[*cursor]struct{})
+	}
+	table.cursors[c] = struct{}{}
+
+	return c, nil
+}
+
+//removed forgets the row at index and follows the last row, which has been moved into its place.
+//The caller must hold the mutex.
+func (c *cursor) removed(index, last int) {
+	var pending = c.pending[:0]
+	for _, i := range c.pending {
+		switch i {
+		case index:
+			continue
+		case last:
+			i = index
+		}
+		pending = append(pending, i)
+	}
+	c.pending = pending
+}
+
+//Next advances the cursor to the next row.
+func (c *cursor) Next() bool {
+	if c.err != nil {
+		return false
+	}
+
+	if err := c.ctx.Err(); err != nil {
+		c.err = err
+		return false
+	}
+
+	mutex.RLock()
+	if len(c.pending) == 0 {
+		mutex.RUnlock()
+		c.Close()
+		return false
+	}
+
+	c.row = reflect.New(c.table.rtype).Elem()
+	c.row.Set(c.table.slice.Index(c.pending[0]))
+	c.pending = c.pending[1:]
+	mutex.RUnlock()
+
+	return true
+}
+
+//Scan reads the current row into the given viewer.
+func (c *cursor) Scan(v Viewer) error {
+	if v.Master() {
+		return ErrIllegalMaster
+	}
+	if !c.row.IsValid() {
+		return ErrNotFound
+	}
+
+	for i := 0; i < v.Columns(); i++ {
+		var column = v.Column(i)
+		if field := c.row.FieldByName(column.Column()); field.IsValid() {
+			reflect.ValueOf(Mutate(v, column).Pointer()).Elem().Set(clone(field))
+		}
+	}
+
+	return nil
+}
+
+//Err returns the error, if any, that was encountered while advancing the cursor.
+func (c *cursor) Err() error {
+	return c.err
+}
+
+//Close closes the cursor.
+func (c *cursor) Close() error {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	delete(c.table.cursors, c)
+	c.pending = nil
+	return nil
+}
@@ -2,20 +2,27 @@ package db
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
+	"math/big"
 	"reflect"
-	"sort"
+	"strconv"
+	"strings"
 )
 
 type selection struct {
-	b Builtin
+	db  tables
+	ctx context.Context
 
 	table string
 
 	conditions []func(reflect.Value) bool
 	updates    []func(reflect.Value) error
 
+	//lookups are the conditions that can be answered by an index.
+	lookups []Condition
+
 	sort  Sorter
 	sorts []Sorter
 
@@ -25,36 +32,211 @@ type selection struct {
 	offset, length int
 
 	columns []Variable
+
+	groups []Variable
 }
 
-func (s *selection) addCondition(c Condition) {
+//predicate returns a function that reports whether a row matches the operator of the given condition.
+//The cases of the condition are not considered.
+func predicate(c Condition) func(reflect.Value) bool {
+	if len(c.Path) > 0 || c.Operator == OpHasKey || c.Operator == OpContainsJSON {
+		return document(c)
+	}
+
+	var match = operate(c)
+
+	switch c.Operator {
+	case OpNotEquals, OpLessThan, OpGreaterThan, OpLessOrEqual, OpGreaterOrEqual, OpBetween, OpIn, OpNotIn:
+		//Like SQL, NULL values are never compared.
+		return func(v reflect.Value) bool {
+			return !Null(v.FieldByName(c.Column).Interface()) && match(v)
+		}
+	default:
+		return match
+	}
+}
+
+//operate returns a function that reports whether a row matches the operator of the given condition.
+func operate(c Condition) func(reflect.Value) bool {
 	switch c.Operator {
 	case OpTrue:
-		return
+		return func(v reflect.Value) bool {
+			return true
+		}
 	case OpFalse:
-		s.conditions = append(s.conditions, func(v reflect.Value) bool {
+		return func(v reflect.Value) bool {
 			return false
-		})
+		}
 	case OpEquals:
-		s.conditions = append(s.conditions, func(v reflect.Value) bool {
-			return reflect.DeepEqual(v.FieldByName(c.Column).Interface(), c.Value)
-		})
+		return func(v reflect.Value) bool {
+			return same(v.FieldByName(c.Column).Interface(), c.Value)
+		}
 	case OpNotEquals:
-		s.conditions = append(s.conditions, func(v reflect.Value) bool {
-			return !reflect.DeepEqual(v.FieldByName(c.Column).Interface(), c.Value)
-		})
+		return func(v reflect.Value) bool {
+			return !same(v.FieldByName(c.Column).Interface(), c.Value)
+		}
 	case OpDivisibleBy:
-		s.conditions = append(s.conditions, func(v reflect.Value) bool {
+		return func(v reflect.Value) bool {
 			return v.FieldByName(c.Column).Interface().(int64)%c.Value.(int64) == 0
-		})
+		}
+	case OpLessThan:
+		return func(v reflect.Value) bool {
+			return compare(v.FieldByName(c.Column).Interface(), c.Value)
+		}
+	case OpContains:
+		return func(v reflect.Value) bool {
+			return strings.Contains(v.FieldByName(c.Column).String(), c.Value.(string))
+		}
+	case OpHasPrefix:
+		return func(v reflect.Value) bool {
+			return strings.HasPrefix(v.FieldByName(c.Column).String(), c.Value.(string))
+		}
+	case OpGreaterThan:
+		return func(v reflect.Value) bool {
+			return compare(c.Value, v.FieldByName(c.Column).Interface())
+		}
+	case OpLessOrEqual:
+		return func(v reflect.Value) bool {
+			return !compare(c.Value, v.FieldByName(c.Column).Interface())
+		}
+	case OpGreaterOrEqual:
+		return func(v reflect.Value) bool {
+			return !compare(v.FieldByName(c.Column).Interface(), c.Value)
+		}
+	case OpBetween:
+		var bounds = reflect.ValueOf(c.Value)
+		var min, max = bounds.Index(0).Interface(), bounds.Index(1).Interface()
+		return func(v reflect.Value) bool {
+			var value = v.FieldByName(c.Column).Interface()
+			return !compare(value, min) && !compare(max, value)
+		}
+	case OpIn:
+		return func(v reflect.Value) bool {
+			return contains(c.Value, v.FieldByName(c.Column).Interface())
+		}
+	case OpNotIn:
+		return func(v reflect.Value) bool {
+			return !contains(c.Value, v.FieldByName(c.Column).Interface())
+		}
+	case OpIsNull:
+		return func(v reflect.Value) bool {
+			return Null(v.FieldByName(c.Column).Interface())
+		}
+	case OpNotNull:
+		return func(v reflect.Value) bool {
+			return !Null(v.FieldByName(c.Column).Interface())
+		}
 	default:
-		panic("not implemented") // TODO: Implement
+		panic("unsupported operator: " + strconv.Itoa(int(c.Operator)))
+	}
+}
+
+//document returns a function that reports whether the value at the path of the given condition, within the JSON document of a row, matches the operator of the condition.
+func document(c Condition) func(reflect.Value) bool {
+	var expected interface{}
+	if value, ok := c.Value.(Document); ok {
+		if err := value.Decode(&expected); err != nil {
+			panic("db.Path: " + err.Error())
+		}
+	}
+
+	return func(v reflect.Value) bool {
+		var decoded interface{}
+		if err := v.FieldByName(c.Column).Interface().(Document).Decode(&decoded); err != nil {
+			return false
+		}
+
+		value, ok := walk(decoded, c.Path)
+		if !ok {
+			return false
+		}
+
+		switch c.Operator {
+		case OpEquals:
+			return reflect.DeepEqual(value, expected)
+		case OpNotEquals:
+			return !reflect.DeepEqual(value, expected)
+		case OpLessThan, OpGreaterThan:
+			var a, b = value, expected
+			if c.Operator == OpGreaterThan {
+				a, b = b, a
+			}
+			switch a := a.(type) {
+			case float64:
+				b, ok := b.(float64)
+				return ok && a < b
+			case string:
+				b, ok := b.(string)
+				return ok && a < b
+			}
+			return false
+		case OpHasKey:
+			switch container := value.(type) {
+			case map[string]interface{}:
+				_, ok := container[c.Value.(string)]
+				return ok
+			case []interface{}:
+				for _, element := range container {
+					if element == c.Value {
+						return true
+					}
+				}
+			}
+			return false
+		case OpContainsJSON:
+			return containsJSON(value, expected)
+		default:
+			panic("unsupported JSON operator: " + strconv.Itoa(int(c.Operator)))
+		}
 	}
 }
 
+//matcher returns a function that reports whether a row matches the given condition tree.
+func matcher(c Condition) func(reflect.Value) bool {
+	var head = predicate(c)
+
+	var cases = make([]func(reflect.Value) bool, len(c.Cases))
+	for i, sub := range c.Cases {
+		cases[i] = matcher(sub)
+	}
+
+	return func(v reflect.Value) bool {
+		var result = head(v)
+		for _, match := range cases {
+			if c.Invert {
+				result = result && match(v)
+			} else {
+				result = result || match(v)
+			}
+		}
+		return result != c.Negate
+	}
+}
+
+func (s *selection) addCondition(c Condition) {
+	if c.Operator == OpTrue && len(c.Cases) == 0 && !c.Negate {
+		return
+	}
+	if c.Operator == OpEquals && len(c.Cases) == 0 && len(c.Path) == 0 && !c.Negate && (c.Table == "" || c.Table == s.table) {
+		s.lookups = append(s.lookups, c)
+	}
+	s.conditions = append(s.conditions, matcher(c))
+}
+
+//contains returns true if the given slice contains the given value.
+func contains(slice, value interface{}) bool {
+	var values = reflect.ValueOf(slice)
+	for i := 0; i < values.Len(); i++ {
+		if same(values.Index(i).Interface(), value) {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *selection) addUpdate(u Update) {
 	s.updates = append(s.updates, func(v reflect.Value) error {
-		v.FieldByName(u.Column).Set(reflect.ValueOf(u.Value))
+		v.FieldByName(u.Column).Set(clone(reflect.ValueOf(u.Value)))
 		return nil
 	})
 
@@ -63,17 +245,33 @@ func (s *selection) addUpdate(u Update) {
 	}
 }
 
+//indexes returns true if any of the updates change an indexed column of the table.
+func indexes(table *storage, updates ...Update) bool {
+	for _, update := range updates {
+		if _, ok := table.indexes[update.Column]; ok {
+			return true
+		}
+		if update.Then != nil && indexes(table, *update.Then) {
+			return true
+		}
+	}
+	return false
+}
+
 func (s selection) MarshalJSON() ([]byte, error) {
 	mutex.RLock()
 	defer mutex.RUnlock()
 
-	var table = database[index(s.b, s.table)]
+	var table = s.db.lookup(s.table)
 
 	if table == nil {
 		return nil, ErrTableNotFound
 	}
 
-	var results = s.query(table)
+	results, err := s.query(table)
+	if err != nil {
+		return nil, err
+	}
 
 	var buffer bytes.Buffer
 
@@ -100,13 +298,18 @@ func (s selection) Count(v Viewable) (int, error) {
 	mutex.RLock()
 	defer mutex.RUnlock()
 
-	var table = database[index(s.b, s.table)]
+	var table = s.db.lookup(s.table)
 
 	if table == nil {
 		return 0, ErrTableNotFound
 	}
 
-	return len(s.query(table)), nil
+	results, err := s.query(table)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(results), nil
 }
 
 //Sum returns the sum amount of the values of all selected rows.
@@ -114,21 +317,36 @@ func (s selection) Sum(v Variable) error {
 	mutex.RLock()
 	defer mutex.RUnlock()
 
-	var table = database[index(s.b, s.table)]
+	var table = s.db.lookup(s.table)
 
 	if table == nil {
 		return ErrTableNotFound
 	}
 
-	var results = s.query(table)
+	results, err := s.query(table)
+	if err != nil {
+		return err
+	}
 
 	var sum = v.Pointer()
 
 	reflect.ValueOf(sum).Elem().Set(reflect.Zero(reflect.ValueOf(sum).Elem().Type()))
 
+	//The sum of a nullable column is NULL, unless there are values to sum.
+	var nullable = reflect.ValueOf(sum).Elem()
+	if nullable.Kind() == reflect.Ptr {
+		sum = reflect.New(nullable.Type().Elem()).Interface()
+	}
+
 	for _, index := range results {
 		var value = table.slice.Index(index).FieldByName(v.Column()).Interface()
-		switch val := value.(type) {
+		if Null(value) {
+			continue
+		}
+		if nullable.Kind() == reflect.Ptr {
+			nullable.Set(reflect.ValueOf(sum))
+		}
+		switch val := deref(value).(type) {
 		case uint:
 			*(sum.(*uint)) += val
 		case uint8:
@@ -156,6 +374,13 @@ func (s selection) Sum(v Variable) error {
 		case float64:
 			*(sum.(*float64)) += val
 
+		case Dec:
+			total, err := sum.(*Dec).add(val)
+			if err != nil {
+				return err
+			}
+			*(sum.(*Dec)) = total
+
 		default:
 			return errors.New("cannot sum type: " + reflect.TypeOf(sum).Elem().String())
 		}
@@ -169,19 +394,34 @@ func (s selection) Average(v Viewable) (float64, error) {
 	mutex.RLock()
 	defer mutex.RUnlock()
 
-	var table = database[index(s.b, s.table)]
+	var table = s.db.lookup(s.table)
 
 	if table == nil {
 		return 0, ErrTableNotFound
 	}
 
-	var results = s.query(table)
+	results, err := s.query(table)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(results) == 0 {
+		return 0, ErrNotFound
+	}
 
 	var avg float64
+	var count int
+
+	//Decimals are averaged exactly.
+	var exact *big.Rat
 
 	for _, index := range results {
 		var value = table.slice.Index(index).FieldByName(v.Column()).Interface()
-		switch val := value.(type) {
+		if Null(value) {
+			continue
+		}
+		count++
+		switch val := deref(value).(type) {
 		case int:
 			avg += float64(val)
 		case int8:
@@ -196,12 +436,118 @@ func (s selection) Average(v Viewable) (float64, error) {
 			avg += float64(val)
 		case float64:
 			avg += float64(val)
+		case Dec:
+			r, err := val.Rat()
+			if err != nil {
+				return 0, err
+			}
+			if exact == nil {
+				exact = new(big.Rat)
+			}
+			exact.Add(exact, r)
 		default:
 			return 0, errors.New("cannot average type: " + reflect.TypeOf(value).Elem().String())
 		}
 	}
 
-	return avg / float64(len(results)), nil
+	//Only NULL values.
+	if count == 0 {
+		return 0, ErrNotFound
+	}
+
+	if exact != nil {
+		avg, _ = exact.Quo(exact, new(big.Rat).SetInt64(int64(count))).Float64()
+		return avg, nil
+	}
+
+	return avg / float64(count), nil
+}
+
+//extreme sets the variable to the smallest value in its column of all selected rows, or the largest if largest is true.
+func (s selection) extreme(v Variable, largest bool) error {
+	mutex.RLock()
+	defer mutex.RUnlock()
+
+	var table = s.db.lookup(s.table)
+
+	if table == nil {
+		return ErrTableNotFound
+	}
+
+	results, err := s.query(table)
+	if err != nil {
+		return err
+	}
+
+	if len(results) == 0 {
+		return ErrNotFound
+	}
+
+	var result reflect.Value
+	for _, index := range results {
+		var value = table.slice.Index(index).FieldByName(v.Column())
+		if Null(value.Interface()) {
+			continue
+		}
+		if !result.IsValid() {
+			result = value
+			continue
+		}
+		if largest {
+			if compare(result.Interface(), value.Interface()) {
+				result = value
+			}
+		} else if compare(value.Interface(), result.Interface()) {
+			result = value
+		}
+	}
+
+	//Only NULL values.
+	if !result.IsValid() {
+		return ErrNotFound
+	}
+
+	reflect.ValueOf(v.Pointer()).Elem().Set(clone(result))
+
+	return nil
+}
+
+//Min sets the variable to the smallest value in its column of all selected rows.
+func (s selection) Min(v Variable) error {
+	return s.extreme(v, false)
+}
+
+//Max sets the variable to the largest value in its column of all selected rows.
+func (s selection) Max(v Variable) error {
+	return s.extreme(v, true)
+}
+
+//CountDistinct returns the number of distinct values in the given column of all selected rows.
+func (s selection) CountDistinct(v Viewable) (int, error) {
+	mutex.RLock()
+	defer mutex.RUnlock()
+
+	var table = s.db.lookup(s.table)
+
+	if table == nil {
+		return 0, ErrTableNotFound
+	}
+
+	results, err := s.query(table)
+	if err != nil {
+		return 0, err
+	}
+
+	var distinct = make(map[interface{}]struct{})
+	for _, index := range results {
+		var value = table.slice.Index(index).FieldByName(v.Column()).Interface()
+		if Null(value) {
+			continue
+		}
+		distinct[hashable(value)] = struct{}{}
+	}
+
+	return len(distinct), nil
 }
 
 //Update updates the selected items with the given updates.
@@ -218,19 +564,67 @@ func (s selection) Update(update Update, updates ...Update) (int, error) {
 		s.addUpdate(update)
 	}
 
-	var table = database[index(s.b, update.Table)]
+	var table = s.db.modify(update.Table)
 
 	if table == nil {
 		return 0, ErrTableNotFound
 	}
 
-	var results = s.query(table)
+	results, err := s.query(table)
+	if err != nil {
+		return 0, err
+	}
+
+	//Keep the previous rows, in case the updates violate a unique index or a foreign key, or cannot be journaled.
+	var previous = make([]reflect.Value, len(results))
+	for i, index := range results {
+		previous[i] = reflect.New(table.rtype).Elem()
+		previous[i].Set(table.slice.Index(index))
+	}
+
+	restore := func() {
+		for i, index := range results {
+			table.slice.Index(index).Set(previous[i])
+		}
+		table.reindex()
+	}
 
 	for _, index := range results {
 		row := table.slice.Index(index)
 		for _, update := range s.updates {
 			update(row)
 		}
+		if err := table.fit(row); err != nil {
+			restore()
+			return 0, err
+		}
+	}
+
+	if len(results) > 0 && (indexes(table, update) || indexes(table, updates...)) {
+		table.reindex()
+	}
+
+	var rows = make([]reflect.Value, len(results))
+	for i, index := range results {
+		rows[i] = table.slice.Index(index)
+	}
+
+	if len(table.uniques) > 0 || len(table.foreigns) > 0 {
+		for i, index := range results {
+			var err = referenced(s.db, table, rows[i])
+			if table.conflict(rows[i], index) {
+				err = ErrDuplicateKey
+			}
+			if err != nil {
+				restore()
+				return 0, err
+			}
+		}
+	}
+
+	if err := s.db.journal(s.table, opUpdate, results, rows...); err != nil {
+		restore()
+		return 0, err
 	}
 
 	return len(results), nil
@@ -241,28 +635,50 @@ func (s selection) Delete() (int, error) {
 	mutex.Lock()
 	defer mutex.Unlock()
 
-	var table = database[index(s.b, s.table)]
+	var table = s.db.modify(s.table)
 
 	if table == nil {
 		return 0, ErrTableNotFound
 	}
 
-	var results = s.query(table)
-
-	//Sort indicies so that our indicies stay correct during removal.
-	sort.Sort(sort.Reverse(sort.IntSlice(results)))
+	results, err := s.query(table)
+	if err != nil {
+		return 0, err
+	}
 
+	//Rows that reference the deleted rows are either deleted with them, or prevent the deletion.
+	var deleted = map[string]map[int]bool{s.table: {}}
 	for _, index := range results {
-		var last = table.slice.Len() - 1
-		table.slice.Index(index).Set(table.slice.Index(last))
-		table.slice.Set(table.slice.Slice(0, last))
+		deleted[s.table][index] = true
+	}
+	if err := cascade(s.db, s.table, results, deleted); err != nil {
+		return 0, err
+	}
+
+	//Every deletion is journaled before any rows are removed.
+	var removals = make(map[string][]int, len(deleted))
+	for name, rows := range deleted {
+		removals[name] = descending(rows)
+		if err := s.db.journal(name, opDelete, removals[name]); err != nil {
+			//Snapshots of the tables that were already journaled discard those entries.
+			for journaled := range removals {
+				if journaled != name {
+					s.db.store(journaled, s.db.lookup(journaled))
+				}
+			}
+			return 0, err
+		}
+	}
+
+	for name, indices := range removals {
+		remove(s.db, name, indices)
 	}
 
 	return len(results), nil
 }
 
 func get(variable, value reflect.Value, column string) {
-	variable.Elem().Set(value.FieldByName(column))
+	variable.Elem().Set(clone(value.FieldByName(column)))
 }
 
 //Get loads the given columns of the selection into those columns.
@@ -279,13 +695,18 @@ func (s selection) Get(v Variable, vs ...Variable) (int, error) {
 		return 0, ErrDisconnectedViewer
 	}
 
-	var table = database[index(s.b, v.Table())]
+	var table = s.db.lookup(v.Table())
 
 	if table == nil {
 		return 0, ErrTableNotFound
 	}
 
-	var results = s.query(table)
+	results, err := s.query(table)
+	if err != nil {
+		return 0, err
+	}
+
+	results = s.window(results)
 
 	if len(results) == 0 {
 		return 0, ErrNotFound
@@ -331,16 +752,14 @@ func into(viewer, value reflect.Value) {
 	}
 }
 
-//Search with the given filter and return the results.
-func (b Builtin) Search(f Filter) Results {
+func search(db tables, f Filter) selection {
 	var s selection
-	s.b = b
+	s.db = db
+	s.ctx = f.Context()
 
 	s.table = f.Table
 
-	if f.Condition.Operator != 0 {
-		s.addCondition(f.Condition)
-	}
+	s.addCondition(f.Condition)
 	for _, condition := range f.Conditions {
 		s.addCondition(condition)
 	}
@@ -354,5 +773,17 @@ func (b Builtin) Search(f Filter) Results {
 	s.length = f.Length
 	s.offset = f.Offset
 
+	s.groups = f.Groups
+
 	return s
 }
+
+//Search with the given filter and return the results.
+func (b Builtin) Search(f Filter) Results {
+	return search(b, f)
+}
+
+//Search with the given filter and return the results.
+func (tx *transaction) Search(f Filter) Results {
+	return search(tx, f)
+}
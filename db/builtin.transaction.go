@@ -0,0 +1,262 @@
+package db
+
+import (
+	"context"
+	"reflect"
+	"sync"
+)
+
+//transaction is a copy-on-write transaction on a Builtin database.
+type transaction struct {
+	Builtin
+
+	//changes holds the tables that have been modified by this transaction.
+	//A nil table has been deleted.
+	changes map[string]*storage
+
+	//versions holds the version of each table when the transaction first used it.
+	versions map[string]uint64
+
+	//used guards versions, as tables are looked up by concurrent readers.
+	used sync.Mutex
+
+	done bool
+}
+
+//use records the version of the named table the first time that the transaction uses it.
+//The caller must hold the mutex.
+func (tx *transaction) use(name string) {
+	if tx.versions == nil {
+		return
+	}
+
+	tx.used.Lock()
+	defer tx.used.Unlock()
+
+	if _, ok := tx.versions[name]; !ok {
+		tx.versions[name] = versions[index(tx.Builtin, name)]
+	}
+}
+
+func (tx *transaction) lookup(name string) *storage {
+	if table, ok := tx.changes[name]; ok {
+		return table
+	}
+	tx.use(name)
+	return tx.Builtin.lookup(name)
+}
+
+func (tx *transaction) modify(name string) *storage {
+	if table, ok := tx.changes[name]; ok {
+		return table
+	}
+	tx.use(name)
+
+	var table = tx.Builtin.lookup(name)
+	if table == nil {
+		return nil
+	}
+
+	table = table.clone()
+	tx.changes[name] = table
+
+	return table
+}
+
+func (tx *transaction) store(name string, table *storage) error {
+	tx.swap(name, table)
+	return nil
+}
+
+func (tx *transaction) swap(name string, table *storage) {
+	tx.use(name)
+	tx.changes[name] = table
+}
+
+//load does nothing, the tables of a transaction are persisted when it is committed.
+func (tx *transaction) load(name string, table *storage) (bool, error) {
+	return false, nil
+}
+
+//journal does nothing, the tables of a transaction are persisted when it is committed.
+func (tx *transaction) journal(name, operation string, indices []int, rows ...reflect.Value) error {
+	return nil
+}
+
+func (tx *transaction) connect(v Viewer) {
+	Connect(v, tx)
+}
+
+//Connect connects the given viewer to view this transaction.
+//It then returns the transaction.
+func (tx *transaction) Connect(first Viewer, more ...Viewer) Driver {
+	tx.connect(first)
+	for _, viewer := range more {
+		tx.connect(viewer)
+	}
+	return tx
+}
+
+//Sync syncs the Tables with the transaction.
+func (tx *transaction) Sync(table Table, tables ...Table) error {
+	if tx.done {
+		return ErrTransactionDone
+	}
+	if err := syncTable(tx, table); err != nil {
+		return err
+	}
+	for _, table := range tables {
+		if err := syncTable(tx, table); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//Plan returns the changes that Sync would make to the transaction, without making them.
+func (tx *transaction) Plan(table Table, tables ...Table) (Diff, error) {
+	if tx.done {
+		return Diff{}, ErrTransactionDone
+	}
+
+	mutex.RLock()
+	defer mutex.RUnlock()
+
+	var diff = planTable(tx, table)
+	for _, table := range tables {
+		diff.Changes = append(diff.Changes, planTable(tx, table).Changes...)
+	}
+	return diff, nil
+}
+
+//Insert inserts the given row into the transaction.
+func (tx *transaction) Insert(row Row, rows ...Row) error {
+	return tx.InsertContext(context.Background(), row, rows...)
+}
+
+//InsertContext inserts the given row into the transaction with the given context.
+func (tx *transaction) InsertContext(ctx context.Context, row Row, rows ...Row) error {
+	if tx.done {
+		return ErrTransactionDone
+	}
+	if row != nil {
+		if err := insertRow(ctx, tx, row); err != nil {
+			return err
+		}
+	}
+	for _, row := range rows {
+		if err := insertRow(ctx, tx, row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//UpsertContext inserts the given row into the transaction with the given context.
+//If a row with the same key already exists, the given columns of that row are overwritten instead (all columns if none are given).
+func (tx *transaction) UpsertContext(ctx context.Context, columns []string, row Row, rows ...Row) error {
+	if tx.done {
+		return ErrTransactionDone
+	}
+	if row != nil {
+		if err := putRow(ctx, tx, row, true, columns); err != nil {
+			return err
+		}
+	}
+	for _, row := range rows {
+		if err := putRow(ctx, tx, row, true, columns); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//Delete deletes the given tables.
+func (tx *transaction) Delete(table Table, tables ...Table) error {
+	if tx.done {
+		return ErrTransactionDone
+	}
+	if table != nil {
+		if err := deleteTable(tx, table); err != nil {
+			return err
+		}
+	}
+	for _, table := range tables {
+		if err := deleteTable(tx, table); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//Empty removes all rows from the given tables so that they are empty.
+func (tx *transaction) Empty(table Table, tables ...Table) error {
+	if tx.done {
+		return ErrTransactionDone
+	}
+	if table != nil {
+		if err := emptyTable(tx, table); err != nil {
+			return err
+		}
+	}
+	for _, table := range tables {
+		if err := emptyTable(tx, table); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//Begin returns ErrNestedTransaction.
+func (tx *transaction) Begin() (Tx, error) {
+	return nil, ErrNestedTransaction
+}
+
+//BeginContext returns ErrNestedTransaction.
+func (tx *transaction) BeginContext(context.Context) (Tx, error) {
+	return nil, ErrNestedTransaction
+}
+
+//Commit replaces the tables of the database with the tables modified by this transaction.
+//Either all of the tables are replaced or, if the commit fails, none of them are and the transaction is rolled back.
+func (tx *transaction) Commit() error {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if tx.done {
+		return ErrTransactionDone
+	}
+	tx.done = true
+
+	//The copies would overwrite any writes made outside of the transaction.
+	for name, version := range tx.versions {
+		if versions[index(tx.Builtin, name)] != version {
+			tx.changes = nil
+			return ErrTransactionConflict
+		}
+	}
+
+	return tx.Builtin.replace(tx.changes)
+}
+
+//Rollback discards the changes made by this transaction.
+func (tx *transaction) Rollback() error {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if tx.done {
+		return ErrTransactionDone
+	}
+	tx.done = true
+	tx.changes = nil
+
+	return nil
+}
+
+//Close rolls back the transaction if it hasn't been committed.
+func (tx *transaction) Close() error {
+	if err := tx.Rollback(); err != nil && err != ErrTransactionDone {
+		return err
+	}
+	return nil
+}
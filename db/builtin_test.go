@@ -1,10 +1,21 @@
 package db
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
 	"testing"
+	"time"
 
 	"qlova.org/should"
 	"qlova.org/should/test"
+	osfs "qlova.store/fs/driver/os"
 )
 
 func Test_Builtin(t *testing.T) {
@@ -16,3 +27,696 @@ func Test_Builtin(t *testing.T) {
 
 	should.NotError(driver.Close()).Test(t)
 }
+
+func Test_Open(t *testing.T) {
+	var driver = Open("builtin", "opened")
+	should.Be(Builtin("opened"))(driver).Test(t)
+
+	driver = Open("builtin://url")
+	should.Be(Builtin("url"))(driver).Test(t)
+
+	//Unknown drivers should be reported by the driver.
+	var Testable TestablesViewer
+	driver = Open("unknown", "arguments").Connect(&Testable)
+
+	should.Error(Sync(Testable)).Test(t)
+	should.Error(Insert(Testable)).Test(t)
+	should.Error(driver.Close()).Test(t)
+
+	var drivers = Drivers()
+	should.Be("builtin")(drivers[0]).Test(t)
+}
+
+func Test_TransactionConflict(t *testing.T) {
+	var Testable TestablesViewer
+	var driver = Open("builtin", "conflict").Connect(&Testable)
+	defer driver.Close()
+
+	should.NotError(Sync(Testable)).Test(t)
+	defer Delete(&Testable)
+
+	var row = Testable
+	row.ID.Set(1)
+	row.Value.Set("100")
+	should.NotError(Insert(row)).Test(t)
+
+	tx, err := driver.Begin()
+	should.NotError(err).Test(t)
+	defer tx.Close()
+
+	var Transacted = Testable
+	tx.Connect(&Transacted)
+
+	var balance = Transacted
+	should.NotError(If(Transacted.ID.Equals(1)).Get(&balance)).Test(t)
+
+	//A concurrent write outside of the transaction.
+	var group sync.WaitGroup
+	group.Add(1)
+	go func() {
+		defer group.Done()
+
+		var row = Testable
+		row.ID.Set(2)
+		row.Value.Set("outside")
+		should.NotError(Insert(row)).Test(t)
+	}()
+	group.Wait()
+
+	_, err = If(Transacted.ID.Equals(1)).Update(Transacted.Value.To("90"))
+	should.NotError(err).Test(t)
+
+	//The commit would lose the concurrent write, so it fails and nothing is committed.
+	should.Be(ErrTransactionConflict)(tx.Commit()).Test(t)
+	should.Be(ErrTransactionDone)(tx.Commit()).Test(t)
+
+	var result = Testable
+	should.NotError(If(Testable.ID.Equals(2)).Get(&result)).Test(t)
+	should.Be("outside")(result.Value.Value()).Test(t)
+
+	should.NotError(If(Testable.ID.Equals(1)).Get(&result)).Test(t)
+	should.Be("100")(result.Value.Value()).Test(t)
+
+	//Writes to other tables do not conflict.
+	tx, err = driver.Begin()
+	should.NotError(err).Test(t)
+	defer tx.Close()
+
+	Transacted = Testable
+	tx.Connect(&Transacted)
+
+	_, err = If(Transacted.ID.Equals(1)).Update(Transacted.Value.To("90"))
+	should.NotError(err).Test(t)
+
+	var Linkable LinkablesViewer
+	driver.Connect(&Linkable)
+	should.NotError(Sync(Linkable)).Test(t)
+	defer Delete(&Linkable)
+
+	should.NotError(tx.Commit()).Test(t)
+
+	should.NotError(If(Testable.ID.Equals(1)).Get(&result)).Test(t)
+	should.Be("90")(result.Value.Value()).Test(t)
+}
+
+func Test_Persist(t *testing.T) {
+	dir, err := ioutil.TempDir("", "qlovastore")
+	should.NotError(err).Test(t)
+	defer os.RemoveAll(dir)
+
+	root, err := osfs.Open(dir)
+	should.NotError(err).Test(t)
+
+	var driver = Persist("persisted", root)
+
+	test.New(&TestSuite{
+		Driver: driver,
+	})(t)
+
+	var Testable TestablesViewer
+	driver.Connect(&Testable)
+	should.NotError(Sync(Testable)).Test(t)
+
+	var row = Testable
+	for i := int64(1); i <= 3; i++ {
+		row.ID.Set(i)
+		row.Value.Set("Hello")
+		should.NotError(Insert(row)).Test(t)
+	}
+
+	_, err = If(Testable.ID.Equals(2)).Update(Testable.Value.To("World"))
+	should.NotError(err).Test(t)
+
+	_, err = If(Testable.ID.Equals(1)).Delete()
+	should.NotError(err).Test(t)
+
+	//Reload the tables from the root into another database.
+	var Reloaded TestablesViewer
+	Persist("reloaded", root).Connect(&Reloaded)
+	should.NotError(Sync(Reloaded)).Test(t)
+
+	count, err := If(Reloaded.Value.NotEquals("")).Count(Reloaded.ID)
+	should.NotError(err).Test(t)
+	should.Be(2)(count).Test(t)
+
+	count, err = If(Reloaded.Value.Equals("World")).Count(Reloaded.ID)
+	should.NotError(err).Test(t)
+	should.Be(1)(count).Test(t)
+
+	//Snapshots include the journal.
+	should.NotError(Sync(Testable)).Test(t)
+	should.NotError(Sync(Reloaded)).Test(t)
+
+	count, err = If(Reloaded.ID.Equals(3)).Count(Reloaded.ID)
+	should.NotError(err).Test(t)
+	should.Be(1)(count).Test(t)
+
+	//The snapshot is replaced, not overwritten, and only when the rows have changed.
+	var snapshot = filepath.Join(dir, "testable", "snapshot.json")
+	_, err = os.Stat(snapshot + ".tmp")
+	should.Be(true)(os.IsNotExist(err)).Test(t)
+
+	var past = time.Now().Add(-time.Hour)
+	should.NotError(os.Chtimes(snapshot, past, past)).Test(t)
+	should.NotError(Sync(Testable)).Test(t)
+
+	info, err := os.Stat(snapshot)
+	should.NotError(err).Test(t)
+	should.Be(true)(info.ModTime().Equal(past)).Test(t)
+
+	//Writes that cannot be journaled are not made.
+	var journal = filepath.Join(dir, "testable", "journal")
+	should.NotError(os.RemoveAll(journal)).Test(t)
+	should.NotError(ioutil.WriteFile(journal, nil, 0666)).Test(t)
+
+	row.ID.Set(4)
+	should.Error(Insert(row)).Test(t)
+
+	should.Error(If(Testable.ID.Equals(2)).Update(Testable.Value.To("Hello"))).Test(t)
+	should.Error(If(Testable.ID.Equals(2)).Delete()).Test(t)
+	should.Error(Empty(&Testable)).Test(t)
+
+	count, err = If(Testable.Value.Equals("World")).Count(Testable.ID)
+	should.NotError(err).Test(t)
+	should.Be(1)(count).Test(t)
+
+	count, err = If(Testable.Value.NotEquals("")).Count(Testable.ID)
+	should.NotError(err).Test(t)
+	should.Be(2)(count).Test(t)
+
+	should.NotError(os.Remove(journal)).Test(t)
+	should.NotError(Insert(row)).Test(t)
+
+	should.NotError(Delete(&Testable)).Test(t)
+}
+
+func Test_Upsert(t *testing.T) {
+	var Upsertable struct {
+		View `db:"upsertable"`
+
+		Owner Int64  `db:",key"`
+		Name  String `db:",key"`
+		Group String `db:",index"`
+	}
+
+	var driver = Open("builtin", "upsert").Connect(&Upsertable)
+	defer driver.Close()
+
+	should.NotError(Sync(Upsertable)).Test(t)
+	defer Delete(&Upsertable)
+
+	var row = Upsertable
+	for i := int64(0); i < 10; i++ {
+		row.Owner.Set(i % 2)
+		row.Name.Set(fmt.Sprint(i))
+		row.Group.Set("even")
+		should.NotError(Insert(row)).Test(t)
+	}
+
+	//Composite keys only match rows that have the same value in every key column.
+	row.Owner.Set(1)
+	row.Name.Set("1")
+	should.Be(ErrDuplicateKey)(Insert(row)).Test(t)
+
+	row.Owner.Set(0)
+	should.NotError(Insert(row)).Test(t)
+
+	row.Owner.Set(1)
+	row.Group.Set("odd")
+	should.NotError(Upsert(row)).Test(t)
+
+	count, err := If(Upsertable.Owner.Equals(1)).Count(Upsertable.Name)
+	should.NotError(err).Test(t)
+	should.Be(5)(count).Test(t)
+
+	count, err = If(Upsertable.Owner.Equals(0)).Count(Upsertable.Name)
+	should.NotError(err).Test(t)
+	should.Be(6)(count).Test(t)
+
+	//The index entries of the upserted row are moved.
+	count, err = If(Upsertable.Group.Equals("odd")).Count(Upsertable.Name)
+	should.NotError(err).Test(t)
+	should.Be(1)(count).Test(t)
+
+	count, err = If(Upsertable.Group.Equals("even")).Count(Upsertable.Name)
+	should.NotError(err).Test(t)
+	should.Be(10)(count).Test(t)
+
+	rows, err := If(Upsertable.Group.Equals("even")).Rows()
+	should.NotError(err).Test(t)
+
+	var names []string
+	for rows.Next() {
+		should.NotError(rows.Scan(&row)).Test(t)
+		names = append(names, row.Name.Value())
+	}
+	should.NotError(rows.Close()).Test(t)
+
+	//Rows keep their order in the index.
+	should.Be([]string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "1"})(names).Test(t)
+}
+
+func Test_Decimal(t *testing.T) {
+	var Decimalable struct {
+		View `db:"decimalable"`
+
+		ID    Int64   `db:",key"`
+		Price Decimal `db:",precision=10,scale=2"`
+	}
+
+	var driver = Open("builtin", "decimal").Connect(&Decimalable)
+	defer driver.Close()
+
+	should.Be(10)(Decimalable.Price.Precision()).Test(t)
+	should.Be(2)(Decimalable.Price.Scale()).Test(t)
+
+	should.NotError(Sync(Decimalable)).Test(t)
+	defer Delete(&Decimalable)
+
+	for i, price := range []Dec{"0.10", "0.20", "0.30"} {
+		var row = Decimalable
+		row.ID.Set(int64(i + 1))
+		row.Price.Set(price)
+		should.NotError(Insert(row)).Test(t)
+	}
+
+	//Sums are exact, unlike 0.1 + 0.2 + 0.3 as floats.
+	var sum = Decimalable
+	should.NotError(If(Decimalable.ID.NotEquals(0)).Sum(&sum.Price)).Test(t)
+	should.Be(Dec("0.60"))(sum.Price.Value()).Test(t)
+
+	avg, err := If(Decimalable.ID.NotEquals(0)).Average(Decimalable.Price)
+	should.NotError(err).Test(t)
+	should.Be(0.2)(avg).Test(t)
+
+	//Decimals compare by value.
+	count, err := If(Decimalable.Price.Equals("0.1")).Count(Decimalable.ID)
+	should.NotError(err).Test(t)
+	should.Be(1)(count).Test(t)
+
+	count, err = If(Decimalable.Price.GreaterThan("0.15")).Count(Decimalable.ID)
+	should.NotError(err).Test(t)
+	should.Be(2)(count).Test(t)
+
+	var result = Decimalable
+	should.NotError(If(Decimalable.ID.Equals(1)).Get(&result)).Test(t)
+
+	encoded, err := json.Marshal(result.Price)
+	should.NotError(err).Test(t)
+	should.Be(`"0.10"`)(string(encoded)).Test(t)
+
+	var decoded Dec
+	should.NotError(json.Unmarshal([]byte(`0.10`), &decoded)).Test(t)
+	should.Be(Dec("0.10"))(decoded).Test(t)
+
+	should.Be(Dec("0.333"))(NewDec(big.NewRat(1, 3))[:5]).Test(t)
+	should.Be(Dec("2.5"))(NewDec(big.NewRat(5, 2))).Test(t)
+}
+
+func Test_JSONOrder(t *testing.T) {
+	var Orderable struct {
+		View `db:"orderable"`
+
+		ID       Int64 `db:",key"`
+		Document JSON
+	}
+
+	var driver = Open("builtin", "jsonorder").Connect(&Orderable)
+	defer driver.Close()
+
+	should.NotError(Sync(Orderable)).Test(t)
+	defer Delete(&Orderable)
+
+	//Documents are ordered like postgres orders jsonb values, not by their encoding.
+	for i, document := range []Document{`{"a":1}`, `[1,2]`, `[3]`, `true`, `10`, `2`, `"b"`, `null`} {
+		var row = Orderable
+		row.ID.Set(int64(i + 1))
+		row.Document.Set(document)
+		should.NotError(Insert(row)).Test(t)
+	}
+
+	var result = Orderable
+	should.NotError(
+		If(Orderable.ID.NotEquals(0)).SortBy(Orderable.Document.Increasing()).Slice(0, 8, &result.ID).Read(),
+	).Test(t)
+
+	var order []int64
+	for i := Range(&result); i.Next(); {
+		order = append(order, result.ID.Value())
+	}
+	should.Be([]int64{8, 7, 6, 5, 4, 3, 2, 1})(order).Test(t)
+}
+
+func Test_Ordered(t *testing.T) {
+	var ordering = []string{"LessThan", "GreaterThan", "LessOrEqual", "GreaterOrEqual", "Between"}
+
+	//Only columns with ordered values can be compared.
+	for _, column := range []interface{}{Int64{}, Float64{}, String{}, Time{}, Decimal{}, NullInt64{}, NullString{}} {
+		for _, method := range ordering {
+			_, ok := reflect.TypeOf(column).MethodByName(method)
+			should.Be(true)(ok).Test(t)
+		}
+	}
+	for _, column := range []interface{}{Bool{}, NullBool{}, Bytes{}, JSON{}} {
+		for _, method := range ordering {
+			_, ok := reflect.TypeOf(column).MethodByName(method)
+			should.Be(false)(ok).Test(t)
+		}
+	}
+
+	//Unordered columns can still be matched against a list of values.
+	var document = JSON{}.In(Document(`{"a":1}`), Document(`[1]`))
+	should.Be(OpIn)(document.Operator).Test(t)
+}
+
+func Test_Rows(t *testing.T) {
+	var Testable TestablesViewer
+	var driver = Open("builtin", "rows").Connect(&Testable)
+	defer driver.Close()
+
+	should.NotError(Sync(Testable)).Test(t)
+	defer Delete(&Testable)
+
+	for i := 1; i <= 4; i++ {
+		var row = Testable
+		row.ID.Set(int64(i))
+		row.Value.Set("Before")
+		should.NotError(Insert(row)).Test(t)
+	}
+
+	rows, err := If(Testable.ID.NotEquals(0)).SortBy(Testable.ID.Increasing()).Rows()
+	should.NotError(err).Test(t)
+	defer rows.Close()
+
+	//Rows are read when the cursor reaches them, so changes made to them before then are seen.
+	var ids []int64
+	var values []string
+	for rows.Next() {
+		var row = Testable
+		should.NotError(rows.Scan(&row)).Test(t)
+		ids = append(ids, row.ID.Value())
+		values = append(values, row.Value.Value())
+
+		if row.ID.Value() == 1 {
+			_, err := If(Testable.ID.Equals(1)).Delete()
+			should.NotError(err).Test(t)
+			_, err = If(Testable.ID.Equals(4)).Delete()
+			should.NotError(err).Test(t)
+			_, err = If(Testable.ID.Equals(3)).Update(Testable.Value.To("After"))
+			should.NotError(err).Test(t)
+		}
+	}
+	should.NotError(rows.Err()).Test(t)
+
+	should.Be([]int64{1, 2, 3})(ids).Test(t)
+	should.Be([]string{"Before", "Before", "After"})(values).Test(t)
+}
+
+func Test_Migrate(t *testing.T) {
+	type MigratableViewer struct {
+		View `db:"migratable"`
+
+		ID   Int64 `db:",key"`
+		Name String
+	}
+
+	var driver = Open("builtin", "migrate")
+	defer driver.Close()
+
+	var applied = make(map[int64]int)
+	var counter sync.Mutex
+
+	var count = func(version int64) {
+		counter.Lock()
+		applied[version]++
+		counter.Unlock()
+	}
+
+	var migrator = Migrations(driver,
+		Migration{
+			Version: 2,
+			Name:    "insert first row",
+			Up: func(tx Tx) error {
+				count(2)
+				var Migratable MigratableViewer
+				tx.Connect(&Migratable)
+
+				var row = Migratable
+				row.ID.Set(1)
+				row.Name.Set("first")
+				return tx.Insert(row)
+			},
+			Down: func(tx Tx) error {
+				var Migratable MigratableViewer
+				tx.Connect(&Migratable)
+
+				_, err := If(Migratable.ID.Equals(1)).Delete()
+				return err
+			},
+		},
+		Migration{
+			Version: 1,
+			Name:    "create migratable",
+			Up: func(tx Tx) error {
+				count(1)
+				var Migratable MigratableViewer
+				tx.Connect(&Migratable)
+				return tx.Sync(Migratable)
+			},
+			Down: func(tx Tx) error {
+				var Migratable MigratableViewer
+				tx.Connect(&Migratable)
+				return tx.Delete(Migratable)
+			},
+		},
+	)
+
+	version, err := migrator.Version()
+	should.NotError(err).Test(t)
+	should.Be(int64(0))(version).Test(t)
+
+	//Concurrent migrators apply each migration once.
+	var group sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		group.Add(1)
+		go func() {
+			defer group.Done()
+			should.NotError(migrator.Latest()).Test(t)
+		}()
+	}
+	group.Wait()
+
+	should.Be(1)(applied[1]).Test(t)
+	should.Be(1)(applied[2]).Test(t)
+
+	version, err = migrator.Version()
+	should.NotError(err).Test(t)
+	should.Be(int64(2))(version).Test(t)
+
+	var Migratable MigratableViewer
+	driver.Connect(&Migratable)
+
+	var result = Migratable
+	should.NotError(If(Migratable.ID.Equals(1)).Get(&result)).Test(t)
+	should.Be("first")(result.Name.Value()).Test(t)
+
+	should.NotError(migrator.Rollback()).Test(t)
+	should.Be(ErrNotFound)(If(Migratable.ID.Equals(1)).Get(&result)).Test(t)
+
+	version, err = migrator.Version()
+	should.NotError(err).Test(t)
+	should.Be(int64(1))(version).Test(t)
+
+	//Failed migrations are rolled back, along with their bookkeeping.
+	var failing = Migrations(driver, Migration{
+		Version: 1,
+		Name:    "create migratable",
+	}, Migration{
+		Version: 2,
+		Name:    "fail",
+		Up: func(tx Tx) error {
+			var Migratable MigratableViewer
+			tx.Connect(&Migratable)
+
+			var row = Migratable
+			row.ID.Set(2)
+			if err := tx.Insert(row); err != nil {
+				return err
+			}
+			return ErrNotFound
+		},
+	})
+	should.Error(failing.Latest()).Test(t)
+	should.Be(ErrNotFound)(If(Migratable.ID.Equals(2)).Get(&result)).Test(t)
+
+	version, err = failing.Version()
+	should.NotError(err).Test(t)
+	should.Be(int64(1))(version).Test(t)
+
+	//Migrations without a Down function cannot be reverted.
+	should.Be(ErrIrreversibleMigration)(failing.Migrate(0)).Test(t)
+
+	should.NotError(migrator.Migrate(0)).Test(t)
+	should.Be(ErrTableNotFound)(If(Migratable.ID.Equals(1)).Get(&result)).Test(t)
+}
+
+func Test_Alter(t *testing.T) {
+	type BeforeViewer struct {
+		View `db:"alterable"`
+
+		ID    Int64 `db:",key"`
+		Name  String
+		Extra String
+		Count Int32
+	}
+
+	type AfterViewer struct {
+		View `db:"alterable"`
+
+		ID    Int64 `db:",key"`
+		Title String
+		Count Int64
+	}
+
+	var driver = Open("builtin", "alter")
+	defer driver.Close()
+
+	var Before BeforeViewer
+	driver.Connect(&Before)
+	should.NotError(Sync(Before)).Test(t)
+
+	var row = Before
+	row.ID.Set(1)
+	row.Name.Set("first")
+	row.Extra.Set("dropped")
+	row.Count.Set(2)
+	should.NotError(Insert(row)).Test(t)
+
+	var migrator = Migrations(driver, Migration{
+		Version: 1,
+		Name:    "rename name, drop extra and widen count",
+		Up: func(tx Tx) error {
+			var After AfterViewer
+			tx.Connect(&After)
+
+			if err := RenameColumn(tx, "alterable", "Name", "Title"); err != nil {
+				return err
+			}
+			if err := DropColumn(tx, "alterable", "Extra"); err != nil {
+				return err
+			}
+			return AlterColumn(tx, "alterable", &After.Count)
+		},
+		Down: func(tx Tx) error {
+			var Before BeforeViewer
+			tx.Connect(&Before)
+
+			if err := RenameColumn(tx, "alterable", "Title", "Name"); err != nil {
+				return err
+			}
+			if err := AlterColumn(tx, "alterable", &Before.Count); err != nil {
+				return err
+			}
+			return tx.Sync(Before)
+		},
+	})
+
+	//Migrations do not begin once the context of the migrator is done.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	should.Error(migrator.WithContext(ctx).Latest()).Test(t)
+
+	version, err := migrator.Version()
+	should.NotError(err).Test(t)
+	should.Be(int64(0))(version).Test(t)
+
+	should.NotError(migrator.Latest()).Test(t)
+
+	var After AfterViewer
+	driver.Connect(&After)
+
+	var altered = After
+	should.NotError(If(After.ID.Equals(1)).Get(&altered)).Test(t)
+	should.Be("first")(altered.Title.Value()).Test(t)
+	should.Be(int64(2))(altered.Count.Value()).Test(t)
+
+	//Altered tables can still be written to.
+	altered.ID.Set(2)
+	altered.Title.Set("second")
+	should.NotError(Insert(altered)).Test(t)
+	should.NotError(If(After.ID.Equals(2)).Get(&altered)).Test(t)
+	should.Be("second")(altered.Title.Value()).Test(t)
+
+	should.NotError(migrator.Rollback()).Test(t)
+
+	var reverted = Before
+	should.NotError(If(Before.ID.Equals(1)).Get(&reverted)).Test(t)
+	should.Be("first")(reverted.Name.Value()).Test(t)
+	should.Be("")(reverted.Extra.Value()).Test(t)
+	should.Be(int32(2))(reverted.Count.Value()).Test(t)
+
+	reverted.ID.Set(3)
+	reverted.Name.Set("third")
+	should.NotError(Insert(reverted)).Test(t)
+	should.NotError(If(Before.ID.Equals(3)).Get(&reverted)).Test(t)
+	should.Be("third")(reverted.Name.Value()).Test(t)
+
+	//Each operation leaves a table that rows can be inserted into and read back from.
+	var Renamed struct {
+		View `db:"alterable"`
+
+		ID    Int64 `db:",key"`
+		Title String
+		Extra String
+		Count Int32
+	}
+	driver.Connect(&Renamed)
+
+	should.NotError(RenameColumn(driver, "alterable", "Name", "Title")).Test(t)
+
+	var renamed = Renamed
+	renamed.ID.Set(4)
+	renamed.Title.Set("fourth")
+	renamed.Extra.Set("kept")
+	should.NotError(Insert(renamed)).Test(t)
+	should.NotError(If(Renamed.ID.Equals(4)).Get(&renamed)).Test(t)
+	should.Be("fourth")(renamed.Title.Value()).Test(t)
+	should.Be("kept")(renamed.Extra.Value()).Test(t)
+
+	var Dropped struct {
+		View `db:"alterable"`
+
+		ID    Int64 `db:",key"`
+		Title String
+		Count Int32
+	}
+	driver.Connect(&Dropped)
+
+	should.NotError(DropColumn(driver, "alterable", "Extra")).Test(t)
+
+	var dropped = Dropped
+	dropped.ID.Set(5)
+	dropped.Title.Set("fifth")
+	should.NotError(Insert(dropped)).Test(t)
+	should.NotError(If(Dropped.ID.Equals(5)).Get(&dropped)).Test(t)
+	should.Be("fifth")(dropped.Title.Value()).Test(t)
+
+	should.NotError(AlterColumn(driver, "alterable", &After.Count)).Test(t)
+
+	altered = After
+	altered.ID.Set(6)
+	altered.Count.Set(1 << 40)
+	should.NotError(Insert(altered)).Test(t)
+	should.NotError(If(After.ID.Equals(6)).Get(&altered)).Test(t)
+	should.Be(int64(1 << 40))(altered.Count.Value()).Test(t)
+
+	should.NotError(If(After.ID.Equals(4)).Get(&altered)).Test(t)
+	should.Be("fourth")(altered.Title.Value()).Test(t)
+
+	//Columns that do not exist cannot be altered.
+	should.Error(RenameColumn(driver, "alterable", "Name", "Title")).Test(t)
+	should.Error(DropColumn(driver, "alterable", "Missing")).Test(t)
+	should.Be(ErrTableNotFound)(DropColumn(driver, "missing", "Name")).Test(t)
+
+	should.NotError(Delete(&Before)).Test(t)
+}
@@ -6,6 +6,9 @@ type Condition struct {
 	Operator
 	Value interface{}
 
+	//Path within a JSON column that the condition applies to.
+	Path []string
+
 	View Table
 
 	driver Driver
@@ -14,6 +17,9 @@ type Condition struct {
 	Cases []Condition
 
 	Invert bool
+
+	//If true, the result of the entire condition (including its cases) is inverted.
+	Negate bool
 }
 
 var True = Condition{}
@@ -36,3 +42,36 @@ func Both(a, b Condition) Condition {
 	c.Invert = true
 	return c
 }
+
+//Not returns a condition that is true if the given condition is false.
+func Not(c Condition) Condition {
+	c.Negate = !c.Negate
+	return c
+}
+
+//All returns a condition that is true if all of the given conditions are true.
+func All(first Condition, conditions ...Condition) Condition {
+	return Condition{
+		Table:  first.Table,
+		View:   first.View,
+		driver: first.driver,
+
+		Operator: OpTrue,
+
+		Cases:  append([]Condition{first}, conditions...),
+		Invert: true,
+	}
+}
+
+//Any returns a condition that is true if any of the given conditions are true.
+func Any(first Condition, conditions ...Condition) Condition {
+	return Condition{
+		Table:  first.Table,
+		View:   first.View,
+		driver: first.driver,
+
+		Operator: OpFalse,
+
+		Cases: append([]Condition{first}, conditions...),
+	}
+}
@@ -6,6 +6,7 @@
 package db
 
 import (
+	"context"
 	"encoding/json"
 	"reflect"
 )
@@ -34,9 +35,19 @@ type Driver interface {
 	//If constraints or types do not match up, an error is returned.
 	Sync(Table, ...Table) error
 
+	//Plan returns the changes that Sync would make to the database, without making them.
+	Plan(Table, ...Table) (Diff, error)
+
 	//Insert inserts the given row into the database.
 	Insert(Row, ...Row) error
 
+	//InsertContext inserts the given row into the database with the given context.
+	InsertContext(context.Context, Row, ...Row) error
+
+	//UpsertContext inserts the given row into the database with the given context.
+	//If a row with the same key already exists, the given columns of that row are overwritten instead (all columns if none are given).
+	UpsertContext(ctx context.Context, columns []string, row Row, rows ...Row) error
+
 	//Delete deletes the given tables.
 	Delete(Table, ...Table) error
 
@@ -46,6 +57,9 @@ type Driver interface {
 	//Search returns results for the given filter.
 	Search(Filter) Results
 
+	//Begin starts a transaction.
+	Begin() (Tx, error)
+
 	//Close closes the connection to the database.
 	Close() error
 }
@@ -71,27 +85,67 @@ type Results interface {
 	Sum(Variable) error
 
 	//Average returns the average value in the given column for all results.
+	//Returns ErrNotFound if there are no results.
 	Average(Viewable) (float64, error)
+
+	//Min sets the variable to the smallest value in its column for all results.
+	//Returns ErrNotFound if there are no results.
+	Min(Variable) error
+
+	//Max sets the variable to the largest value in its column for all results.
+	//Returns ErrNotFound if there are no results.
+	Max(Variable) error
+
+	//CountDistinct returns the number of distinct values in the given column for all results.
+	CountDistinct(Viewable) (int, error)
+
+	//Aggregate reads the grouped columns and the aggregates of each group of the results into their variables.
+	//Returns the number of groups.
+	Aggregate(Aggregate, ...Aggregate) (int, error)
+
+	//Rows returns a cursor that reads the results one row at a time.
+	Rows() (Rows, error)
 }
 
-//Open opens a database based on the provided optional arguments.
-//The first argument is a database name and subsequent arguments are passed to it.
-//If no arguments are provided, a builtin database is used.
-func Open(args ...string) Driver {
-	if len(args) == 0 {
-		return Builtin("")
-	}
-	return nil
+//Rows is a cursor over the results of a search.
+//The cursor must be closed after use.
+type Rows interface {
+	//Next advances the cursor to the next row.
+	//Returns false when there are no more rows or an error occured.
+	Next() bool
+
+	//Scan reads the current row into the given viewer.
+	Scan(Viewer) error
+
+	//Err returns the error, if any, that was encountered while advancing the cursor.
+	Err() error
+
+	//Close closes the cursor.
+	Close() error
 }
 
 type Iterator struct {
 	Viewer
 	Index int
+
+	//page is the filter for the next page of a paginating iterator.
+	page *Filter
+	rows Rows
+
+	//count is the number of rows read from the current page.
+	count int
+
+	done bool
+	err  error
 }
 
 //Next loads viewer's next result into the viewer.
 //Returns false when there are no more results.
 func (r *Iterator) Next() bool {
+	if r.page != nil {
+		return r.paginate()
+	}
+
 	var viewer = r.Viewer
 
 	var RowType = reflect.TypeOf(viewer)
@@ -113,6 +167,70 @@ func (r *Iterator) Next() bool {
 	return !last
 }
 
+//paginate loads the next result of the current page into the viewer, fetching the next page when the current page runs out.
+func (r *Iterator) paginate() bool {
+	for !r.done {
+		if r.rows == nil {
+			rows, err := Slicer(*r.page).Rows()
+			if err != nil {
+				r.err = err
+				r.done = true
+				return false
+			}
+			r.rows = rows
+			r.count = 0
+		}
+
+		if r.rows.Next() {
+			if err := r.rows.Scan(r.Viewer); err != nil {
+				r.err = err
+				r.Close()
+				return false
+			}
+			r.count++
+			r.Index++
+			return true
+		}
+
+		if err := r.rows.Err(); err != nil {
+			r.err = err
+			r.Close()
+			return false
+		}
+
+		//A short page is the last page.
+		if r.count < r.page.Length {
+			r.Close()
+			return false
+		}
+
+		if err := r.rows.Close(); err != nil {
+			r.err = err
+			r.done = true
+			return false
+		}
+		r.rows = nil
+		r.page.Offset += r.page.Length
+	}
+	return false
+}
+
+//Err returns the error, if any, that was encountered while fetching the results of the iterator.
+func (r *Iterator) Err() error {
+	return r.err
+}
+
+//Close stops the iterator, it only needs to be called when a paginating iterator is abandoned before Next returns false.
+func (r *Iterator) Close() error {
+	r.done = true
+	if r.rows != nil {
+		err := r.rows.Close()
+		r.rows = nil
+		return err
+	}
+	return nil
+}
+
 //Range returns a new iterator.
 func Range(viewer Viewer) *Iterator {
 	return &Iterator{
@@ -0,0 +1,174 @@
+package db
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+//Dec is an exact decimal number, ie. "12.50", it is the value of a Decimal column.
+//The zero value is zero.
+type Dec string
+
+//maxPlaces is the number of decimal places that numbers without an exact decimal representation are rounded to.
+const maxPlaces = 30
+
+//NewDec returns the given rational number as a decimal.
+//Numbers that have no exact decimal representation, ie. 1/3, are rounded to 30 decimal places.
+func NewDec(r *big.Rat) Dec {
+	var places int
+	var power = big.NewInt(1)
+	var ten = big.NewInt(10)
+	var remainder big.Int
+	for places < maxPlaces && remainder.Mod(power, r.Denom()).Sign() != 0 {
+		power.Mul(power, ten)
+		places++
+	}
+	return Dec(r.FloatString(places))
+}
+
+//Rat returns the decimal as a rational number, so that it can be used for exact arithmetic.
+func (d Dec) Rat() (*big.Rat, error) {
+	if d == "" {
+		return new(big.Rat), nil
+	}
+	r, ok := new(big.Rat).SetString(string(d))
+	if !ok || strings.ContainsRune(string(d), '/') {
+		return nil, fmt.Errorf("invalid decimal %q", string(d))
+	}
+	return r, nil
+}
+
+//Float64 returns the nearest float64 value to the decimal.
+func (d Dec) Float64() float64 {
+	r, err := d.Rat()
+	if err != nil {
+		return 0
+	}
+	f, _ := r.Float64()
+	return f
+}
+
+//String returns the decimal as it was set, or "0" for the zero value.
+func (d Dec) String() string {
+	if d == "" {
+		return "0"
+	}
+	return string(d)
+}
+
+//canonical returns the decimal without trailing zeros, so that equal decimals have the same representation.
+//Invalid decimals are returned as they are.
+func (d Dec) canonical() Dec {
+	r, err := d.Rat()
+	if err != nil {
+		return d
+	}
+	return NewDec(r)
+}
+
+//places returns the number of decimal places of the decimal, or -1 if it is written with an exponent.
+func (d Dec) places() int {
+	if strings.ContainsAny(string(d), "eE") {
+		return -1
+	}
+	if i := strings.IndexByte(string(d), '.'); i >= 0 {
+		return len(d) - i - 1
+	}
+	return 0
+}
+
+//round returns the decimal rounded to the given number of decimal places, with halves rounded away from zero.
+//Returns ErrDecimalOverflow if the rounded decimal has more than precision digits.
+func (d Dec) round(precision, scale int) (Dec, error) {
+	r, err := d.Rat()
+	if err != nil {
+		return d, err
+	}
+
+	var rounded = r.FloatString(scale)
+
+	var integer = strings.TrimLeft(strings.TrimPrefix(rounded, "-"), "0")
+	if i := strings.IndexByte(integer, '.'); i >= 0 {
+		integer = integer[:i]
+	}
+	if len(integer) > precision-scale {
+		return d, ErrDecimalOverflow
+	}
+
+	return Dec(rounded), nil
+}
+
+//add returns the exact sum of the decimals, with as many decimal places as the decimal with the most of them.
+func (d Dec) add(other Dec) (Dec, error) {
+	a, err := d.Rat()
+	if err != nil {
+		return d, err
+	}
+	b, err := other.Rat()
+	if err != nil {
+		return d, err
+	}
+	a.Add(a, b)
+
+	var places = d.places()
+	if other.places() > places {
+		places = other.places()
+	}
+	if d.places() < 0 || other.places() < 0 {
+		return NewDec(a), nil
+	}
+	return Dec(a.FloatString(places)), nil
+}
+
+//Value implements driver.Valuer, decimals are sent to the database as strings.
+func (d Dec) Value() (driver.Value, error) {
+	if _, err := d.Rat(); err != nil {
+		return nil, err
+	}
+	return d.String(), nil
+}
+
+//Scan implements sql.Scanner.
+func (d *Dec) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case nil:
+		*d = ""
+	case []byte:
+		*d = Dec(value)
+	case string:
+		*d = Dec(value)
+	case int64:
+		*d = Dec(strconv.FormatInt(value, 10))
+	case float64:
+		*d = Dec(strconv.FormatFloat(value, 'f', -1, 64))
+	default:
+		*d = Dec(fmt.Sprint(value))
+	}
+	if _, err := d.Rat(); err != nil {
+		return err
+	}
+	return nil
+}
+
+//MarshalJSON encodes the decimal as a JSON string, so that it keeps its exact value.
+func (d Dec) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+//UnmarshalJSON decodes the decimal from a JSON string or number.
+func (d *Dec) UnmarshalJSON(data []byte) error {
+	var value = Dec(strings.Trim(string(data), `"`))
+	if value == "null" {
+		return nil
+	}
+	if _, err := value.Rat(); err != nil {
+		return errors.New("db.Dec: cannot unmarshal " + string(data))
+	}
+	*d = value
+	return nil
+}
@@ -0,0 +1,80 @@
+package where
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"qlova.store/db"
+	"qlova.store/db/driver/internal/reserved"
+)
+
+//MySQL is the dialect of MySQL, it is also used by go-mysql-server.
+var MySQL = Dialect{
+	Table:  reserved.MySQL,
+	Column: reserved.MySQL,
+
+	Placeholder: func(int) string {
+		return "?"
+	},
+
+	JSON: mysqlJSON,
+}
+
+//mysqlJSON writes a condition on a JSON document with the MySQL JSON functions.
+func mysqlJSON(w *Writer, column string, c db.Condition) {
+	var path = jsonPath(c.Path)
+
+	switch c.Operator {
+	case db.OpHasKey:
+		//Either an object with the key or an array with the string.
+		w.WriteString("(JSON_CONTAINS_PATH(" + column + ",'one',")
+		w.Value(path + "." + strconv.Quote(c.Value.(string)))
+		w.WriteString(") OR JSON_CONTAINS(" + column + ",JSON_QUOTE(")
+		w.Value(c.Value)
+		w.WriteString("),")
+		w.Value(path)
+		w.WriteString("))")
+		return
+	case db.OpContainsJSON:
+		w.WriteString("JSON_CONTAINS(" + column + ",")
+		w.Value(c.Value)
+		w.WriteByte(',')
+		w.Value(path)
+		w.WriteByte(')')
+		return
+	}
+
+	w.WriteString("JSON_EXTRACT(" + column + ",")
+	w.Value(path)
+	w.WriteByte(')')
+	switch c.Operator {
+	case db.OpEquals:
+		w.WriteByte('=')
+	case db.OpNotEquals:
+		w.WriteString("!=")
+	case db.OpLessThan:
+		w.WriteByte('<')
+	case db.OpGreaterThan:
+		w.WriteByte('>')
+	default:
+		panic("unsupported JSON operator: " + strconv.Itoa(int(c.Operator)))
+	}
+	w.WriteString("CAST(")
+	w.Value(c.Value)
+	w.WriteString(" AS JSON)")
+}
+
+//jsonPath returns the MySQL path expression for the given path within a JSON document, ie. $."settings"[0]
+func jsonPath(path []string) string {
+	var expression strings.Builder
+	expression.WriteByte('$')
+	for _, key := range path {
+		if index, err := strconv.Atoi(key); err == nil && index >= 0 {
+			fmt.Fprintf(&expression, "[%v]", index)
+		} else {
+			fmt.Fprintf(&expression, ".%v", strconv.Quote(key))
+		}
+	}
+	return expression.String()
+}
@@ -0,0 +1,187 @@
+//Package where renders the conditions of a db.Filter as the WHERE clause of a query, for the SQL drivers.
+package where
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"qlova.store/db"
+)
+
+//Dialect holds the parts of a condition that are written differently by each database.
+type Dialect struct {
+	//Table returns the name of a table that is safe to use in a query.
+	Table func(name string) string
+
+	//Column returns the name of a column that is safe to use in a query.
+	Column func(name string) string
+
+	//Placeholder returns the placeholder of the nth value of the query, counting from 1, ie. $1 or ?
+	Placeholder func(n int) string
+
+	//JSON writes a condition on the path of a JSON document in the given column.
+	JSON func(w *Writer, column string, c db.Condition)
+}
+
+//Writer writes conditions in the dialect of a database, collecting the values that they are compared with.
+type Writer struct {
+	strings.Builder
+	Dialect
+
+	//Values are the values of the placeholders that have been written.
+	Values []interface{}
+
+	//Joined is true if columns need to be qualified by their table.
+	Joined bool
+}
+
+//Value writes a placeholder for the given value.
+func (w *Writer) Value(value interface{}) {
+	w.Values = append(w.Values, value)
+	w.WriteString(w.Placeholder(len(w.Values)))
+}
+
+//Where writes the WHERE clause of the given filter, nothing is written if the filter matches every row.
+func (w *Writer) Where(filter db.Filter) {
+	var trivial = filter.Condition.Operator == db.OpTrue && len(filter.Condition.Cases) == 0 && !filter.Condition.Negate
+
+	if !trivial || len(filter.Conditions) > 0 {
+		w.WriteString(" WHERE ")
+		w.Condition(filter.Condition)
+		for _, condition := range filter.Conditions {
+			w.WriteString(" AND ")
+			w.Condition(condition)
+		}
+	}
+}
+
+//Condition writes the given condition, including its cases.
+func (w *Writer) Condition(c db.Condition) {
+	if c.Negate {
+		w.WriteString("NOT (")
+		defer w.WriteByte(')')
+	}
+
+	if len(c.Cases) > 0 {
+		w.WriteByte('(')
+		defer func() {
+			for _, sub := range c.Cases {
+				if c.Invert {
+					w.WriteString(" AND ")
+				} else {
+					w.WriteString(" OR ")
+				}
+				w.Condition(sub)
+			}
+			w.WriteByte(')')
+		}()
+	}
+
+	if c.Operator == db.OpTrue {
+		w.WriteString("TRUE")
+		return
+	}
+
+	if c.Operator == db.OpFalse {
+		w.WriteString("FALSE")
+		return
+	}
+
+	//An empty IN list is a syntax error.
+	if (c.Operator == db.OpIn || c.Operator == db.OpNotIn) && reflect.ValueOf(c.Value).Len() == 0 {
+		if c.Operator == db.OpIn {
+			w.WriteString("FALSE")
+		} else {
+			w.WriteString("TRUE")
+		}
+		return
+	}
+
+	//NULL is never equal to anything, so it is compared with IS NULL instead.
+	if db.Null(c.Value) {
+		switch c.Operator {
+		case db.OpEquals:
+			c.Operator = db.OpIsNull
+		case db.OpNotEquals:
+			c.Operator = db.OpNotNull
+		}
+	}
+
+	var column = w.Column(c.Column)
+	if w.Joined {
+		column = w.Table(c.Table) + "." + column
+	}
+
+	//Conditions on JSON documents are left to the dialect.
+	if len(c.Path) > 0 || c.Operator == db.OpHasKey || c.Operator == db.OpContainsJSON {
+		w.JSON(w, column, c)
+		return
+	}
+
+	w.WriteString(column)
+	switch c.Operator {
+	case db.OpEquals:
+		w.WriteByte('=')
+	case db.OpDivisibleBy:
+		w.WriteByte('%')
+	case db.OpNotEquals:
+		w.WriteString("!=")
+	case db.OpLessThan:
+		w.WriteByte('<')
+	case db.OpContains:
+		w.WriteString(" LIKE ")
+		c.Value = "%" + EscapeLike(fmt.Sprint(c.Value)) + "%"
+	case db.OpHasPrefix:
+		w.WriteString(" LIKE ")
+		c.Value = EscapeLike(fmt.Sprint(c.Value)) + "%"
+	case db.OpGreaterThan:
+		w.WriteByte('>')
+	case db.OpLessOrEqual:
+		w.WriteString("<=")
+	case db.OpGreaterOrEqual:
+		w.WriteString(">=")
+	case db.OpBetween:
+		var bounds = reflect.ValueOf(c.Value)
+		w.WriteString(" BETWEEN ")
+		w.Value(bounds.Index(0).Interface())
+		w.WriteString(" AND ")
+		w.Value(bounds.Index(1).Interface())
+		return
+	case db.OpIn, db.OpNotIn:
+		var list = reflect.ValueOf(c.Value)
+		if c.Operator == db.OpNotIn {
+			w.WriteString(" NOT")
+		}
+		w.WriteString(" IN (")
+		for i := 0; i < list.Len(); i++ {
+			if i > 0 {
+				w.WriteByte(',')
+			}
+			w.Value(list.Index(i).Interface())
+		}
+		w.WriteByte(')')
+		return
+	case db.OpIsNull:
+		w.WriteString(" IS NULL")
+		return
+	case db.OpNotNull:
+		w.WriteString(" IS NOT NULL")
+		return
+	default:
+		panic("unsupported operator: " + strconv.Itoa(int(c.Operator)))
+	}
+
+	w.Value(c.Value)
+
+	if c.Operator == db.OpDivisibleBy {
+		w.WriteString(`=0`)
+	}
+}
+
+//EscapeLike escapes the special characters of a LIKE pattern, with backslash as the escape character.
+//Backslash is the default escape character of both postgres and MySQL.
+func EscapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
@@ -0,0 +1,43 @@
+package where
+
+import (
+	"testing"
+
+	"qlova.org/should"
+	"qlova.store/db"
+)
+
+func Test_Where(t *testing.T) {
+	var numbered = MySQL
+	numbered.Placeholder = func(n int) string {
+		return "$" + string(rune('0'+n))
+	}
+
+	for _, expectation := range []struct {
+		dialect Dialect
+		filter  db.Filter
+		query   string
+		values  []interface{}
+	}{
+		{MySQL, db.Filter{}, "", nil},
+		{MySQL, db.Filter{Condition: db.Condition{Column: "name", Operator: db.OpContains, Value: "50%_"}},
+			" WHERE name LIKE ?", []interface{}{`%50\%\_%`}},
+		{numbered, db.Filter{Condition: db.Condition{Column: "id", Operator: db.OpBetween, Value: []int64{1, 2}}},
+			" WHERE id BETWEEN $1 AND $2", []interface{}{int64(1), int64(2)}},
+		{MySQL, db.Filter{Condition: db.Condition{Column: "age", Operator: db.OpEquals, Value: (*int64)(nil)}},
+			" WHERE age IS NULL", nil},
+		{MySQL, db.Filter{Condition: db.Condition{Column: "id", Operator: db.OpIn, Value: []int64{}}},
+			" WHERE FALSE", nil},
+		{MySQL, db.Filter{Condition: db.Not(db.Either(
+			db.Condition{Column: "id", Operator: db.OpEquals, Value: 1},
+			db.Condition{Column: "key", Operator: db.OpEquals, Value: 2},
+		))}, " WHERE NOT ((id=? OR `key`=?))", []interface{}{1, 2}},
+		{MySQL, db.Filter{Condition: db.Condition{Column: "settings", Path: []string{"tags", "0"}, Operator: db.OpEquals, Value: db.Document(`"beta"`)}},
+			` WHERE JSON_EXTRACT(settings,?)=CAST(? AS JSON)`, []interface{}{`$."tags"[0]`, db.Document(`"beta"`)}},
+	} {
+		var w = Writer{Dialect: expectation.dialect}
+		w.Where(expectation.filter)
+		should.Be(expectation.query)(w.String()).Test(t)
+		should.Be(expectation.values)(w.Values).Test(t)
+	}
+}
@@ -2,119 +2,521 @@ package liquidsql
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
-	sqle "github.com/liquidata-inc/go-mysql-server"
-	"github.com/liquidata-inc/go-mysql-server/memory"
 	"github.com/liquidata-inc/go-mysql-server/sql"
 
 	"qlova.store/db"
+	"qlova.store/db/driver/internal/where"
 )
 
-//Driver implements db.Driver
+//Driver implements db.Driver with an in-memory go-mysql-server engine.
 type Driver struct {
-	*sqle.Engine
-	*sql.Context
+	*engine
 }
 
-//Open sets the named db connection to the given sql driver and options.
-func open(name db.Connection, driver, options string) error {
+//Connect connects the given viewer to view this database.
+//It then returns the database.
+func (d Driver) Connect(first db.Viewer, more ...db.Viewer) db.Driver {
+	db.Connect(first, d)
+	for _, viewer := range more {
+		db.Connect(viewer, d)
+	}
+	return d
+}
+
+//put inserts the given row.
+//If upsert is true, the given columns of an existing row with the same key are overwritten instead.
+//The caller must hold the engine's lock.
+func (e *engine) put(ctx context.Context, row db.Row, upsert bool, columns []string) error {
+	var insert db.Insertion
+	if err := insert.Row(row); err != nil {
+		return err
+	}
 
-	engine := sqle.NewDefault()
-	engine.AddDatabase(memory.NewDatabase(""))
-	engine.AddDatabase(sql.NewInformationSchemaDatabase(engine.Catalog))
+	var table = cname(row.Row().Table())
+	e.wrote(row.Row().Table())
 
-	db.Open(name, Driver{engine, sql.NewContext(context.Background())})
+	//Auto columns continue from the largest existing value.
+	for i, column := range insert.Columns {
+		if !insert.Autos[i] {
+			continue
+		}
 
-	return nil
+		_, rows, err := e.run(ctx, `SELECT MAX(`+cname(column)+`) FROM `+table)
+		if err != nil {
+			return err
+		}
+
+		var max int64
+		if rows[0][0] != nil {
+			if err := convertAssign(&max, rows[0][0]); err != nil {
+				return err
+			}
+		}
+
+		insert.Generated(i, max+1)
+	}
+
+	//Enforce primary keys, or find the row to overwrite.
+	if primary := key(&insert); len(primary.Columns) > 0 {
+		exists, err := e.duplicate(ctx, table, primary, &insert)
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			if !upsert {
+				return db.ErrDuplicateKey
+			}
+			return e.overwrite(ctx, &insert, primary, columns)
+		}
+	}
+
+	//Enforce unique indexes.
+	for _, index := range db.Indexes(insert.Table) {
+		if !index.Unique {
+			continue
+		}
+
+		duplicate, err := e.duplicate(ctx, table, index, &insert)
+		if err != nil {
+			return err
+		}
+		if duplicate {
+			return db.ErrDuplicateKey
+		}
+	}
+
+	var query strings.Builder
+	query.WriteString(`INSERT INTO `)
+	query.WriteString(table)
+	query.WriteString(` (`)
+
+	for i, column := range insert.Columns {
+		if i > 0 {
+			query.WriteByte(',')
+		}
+		query.WriteString(cname(column))
+	}
+
+	query.WriteString(`) VALUES (`)
+	query.WriteString(placeholders(len(insert.Values)))
+	query.WriteString(`)`)
+
+	_, _, err := e.run(ctx, query.String(), insert.Values...)
+	return err
 }
 
-func (d Driver) Slice(index, length int, values ...db.Value) db.Slicer {
-	panic("not implemented")
+//key returns the primary key of the insertion as an index, the key columns identify the row together.
+func key(insert *db.Insertion) db.Index {
+	var index = db.Index{Name: "PRIMARY", Unique: true}
+	for i, column := range insert.Columns {
+		if insert.Uniques[i] {
+			index.Columns = append(index.Columns, column)
+		}
+	}
+	return index
 }
 
-func (d Driver) Count(v db.Value) (int, error) {
-	panic("not implemented")
+//values returns the values of the insertion in the given columns.
+func values(insert *db.Insertion, columns []string) []interface{} {
+	var values = make([]interface{}, len(columns))
+	for i, column := range columns {
+		for j := range insert.Columns {
+			if insert.Columns[j] == column {
+				values[i] = insert.Values[j]
+				break
+			}
+		}
+	}
+	return values
 }
 
-func (d Driver) Average(v db.Value) (float64, error) {
-	panic("not implemented")
+//duplicate returns true if the given table has a row with the same values as the insertion in the columns of the index.
+//The caller must hold the engine's lock.
+func (e *engine) duplicate(ctx context.Context, table string, index db.Index, insert *db.Insertion) (bool, error) {
+	var conditions = make([]string, len(index.Columns))
+	for i, column := range index.Columns {
+		conditions[i] = cname(column) + `=?`
+	}
+
+	_, rows, err := e.run(ctx, `SELECT COUNT(*) FROM `+table+` WHERE `+strings.Join(conditions, ` AND `),
+		values(insert, index.Columns)...)
+	if err != nil {
+		return false, err
+	}
+
+	var count int64
+	if err := scan(rows[0], &count); err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
 }
 
-//Insert implements db.Driver.Insert
-func (d Driver) Insert(row db.Row, rows ...db.Row) error {
+//unique returns db.ErrDuplicateKey if two rows of the named table have the same values in the key columns,
+//or in the columns of a unique index. Rows with NULL values in an index are never duplicates.
+//The caller must hold the engine's lock.
+func (e *engine) unique(ctx context.Context, name string) error {
+	table, ok := e.tables[name]
+	if !ok {
+		return nil
+	}
 
-	var insert db.Insertion
-	if err := insert.Row(row); err != nil {
-		return err
+	var indexes = db.Indexes(table)
+
+	var primary = db.Index{Name: "PRIMARY", Unique: true}
+	for i := 0; i < table.Columns(); i++ {
+		if table.Column(i).Key() {
+			primary.Columns = append(primary.Columns, table.Column(i).Column())
+		}
+	}
+	if len(primary.Columns) > 0 {
+		indexes = append([]db.Index{primary}, indexes...)
 	}
 
-	var q Query
+	for _, index := range indexes {
+		if !index.Unique {
+			continue
+		}
 
-	q.WriteString(`INSERT INTO `)
-	q.WriteString(insert.Table.Name)
-	q.WriteString(` (`)
+		var columns = make([]string, len(index.Columns))
+		for i, column := range index.Columns {
+			columns[i] = cname(column)
+		}
 
-	for i, column := range insert.Columns {
-		column.Name = filterReservedWord(column.Name)
+		_, rows, err := e.run(ctx, `SELECT `+strings.Join(columns, ",")+` FROM `+cname(name))
+		if err != nil {
+			return err
+		}
 
-		q.WriteString(column.Name)
+		var seen = make(map[string]bool, len(rows))
+	next:
+		for _, row := range rows {
+			for _, value := range row {
+				if value == nil {
+					continue next
+				}
+			}
 
-		if i < len(insert.Columns)-1 {
-			q.WriteByte(',')
+			var key = fmt.Sprintf("%#v", []interface{}(row))
+			if seen[key] {
+				return db.ErrDuplicateKey
+			}
+			seen[key] = true
 		}
 	}
 
-	q.WriteString(`) VALUES (`)
+	return nil
+}
+
+//check returns db.ErrDuplicateKey if any of the given rows would be rejected because of a duplicate key.
+//The caller must hold the engine's lock.
+func (e *engine) check(ctx context.Context, rows []db.Row) error {
+	var seen = make(map[[3]string]bool)
 
-	for i := range insert.Values {
-		q.WriteByte('?')
+	for _, row := range rows {
+		var insert db.Insertion
+		if err := insert.Row(row); err != nil {
+			return err
+		}
+
+		var table = cname(row.Row().Table())
+
+		var indexes = db.Indexes(insert.Table)
+
+		//Generated values are unique.
+		var generated bool
+		for i := range insert.Columns {
+			if insert.Uniques[i] && insert.Autos[i] {
+				generated = true
+			}
+		}
+		if primary := key(&insert); len(primary.Columns) > 0 && !generated {
+			indexes = append([]db.Index{primary}, indexes...)
+		}
+
+		for _, index := range indexes {
+			if !index.Unique {
+				continue
+			}
+
+			var key = [3]string{table, index.Name, fmt.Sprintf("%#v", values(&insert, index.Columns))}
+			if seen[key] {
+				return db.ErrDuplicateKey
+			}
+			seen[key] = true
+
+			duplicate, err := e.duplicate(ctx, table, index, &insert)
+			if err != nil {
+				return err
+			}
+			if duplicate {
+				return db.ErrDuplicateKey
+			}
+		}
+	}
+
+	return nil
+}
+
+//overwrite overwrites the given columns of the row that has the same values as the insertion in the columns of the primary key.
+//The caller must hold the engine's lock.
+func (e *engine) overwrite(ctx context.Context, insert *db.Insertion, primary db.Index, columns []string) error {
+	var overwrites = insert.Overwrites(columns)
+	if len(overwrites) == 0 {
+		return nil
+	}
+
+	var query strings.Builder
+	query.WriteString(`UPDATE `)
+	query.WriteString(cname(insert.Table.Table()))
+	query.WriteString(` SET `)
+
+	var arguments []interface{}
+	for i, j := range overwrites {
+		if i > 0 {
+			query.WriteByte(',')
+		}
+		query.WriteString(cname(insert.Columns[j]))
+		query.WriteString(`=?`)
+		arguments = append(arguments, insert.Values[j])
+	}
 
-		if i < len(insert.Columns)-1 {
-			q.WriteByte(',')
+	query.WriteString(` WHERE `)
+	for i, column := range primary.Columns {
+		if i > 0 {
+			query.WriteString(` AND `)
 		}
+		query.WriteString(cname(column))
+		query.WriteString(`=?`)
 	}
+	arguments = append(arguments, values(insert, primary.Columns)...)
+
+	_, _, err := e.run(ctx, query.String(), arguments...)
+	return err
+}
+
+//Insert inserts the given row into the database.
+func (d Driver) Insert(row db.Row, rows ...db.Row) error {
+	return d.InsertContext(context.Background(), row, rows...)
+}
 
-	q.WriteString(`);`)
+//InsertContext inserts the given row into the database with the given context.
+//Multiple rows are inserted atomically.
+func (d Driver) InsertContext(ctx context.Context, row db.Row, rows ...db.Row) error {
+	d.Lock()
+	defer d.Unlock()
 
-	_, _, err := query(d.Engine, d.Context, q.String(), insert.Values...)
+	//Check every key before inserting anything, so that either all of the rows are inserted or none of them are.
+	if len(rows) > 0 {
+		if err := d.check(ctx, append([]db.Row{row}, rows...)); err != nil {
+			return err
+		}
+	}
 
-	return q.Error(err)
+	if err := d.put(ctx, row, false, nil); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if err := d.put(ctx, row, false, nil); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-//Link implements db.Driver.Link
-func (d Driver) Link(link db.Linker, links ...db.Linker) db.Query {
-	var q Query
-	q.Driver = d
-	q.Table = link.From.Table
+//UpsertContext inserts the given row into the database with the given context.
+//If a row with the same key already exists, the given columns of that row are overwritten instead (all columns if none are given).
+//Multiple rows are upserted atomically.
+func (d Driver) UpsertContext(ctx context.Context, columns []string, row db.Row, rows ...db.Row) error {
+	d.Lock()
+	defer d.Unlock()
+
+	if len(rows) == 0 {
+		return d.put(ctx, row, true, columns)
+	}
+
+	//The tables are restored if any of the rows cannot be upserted.
+	type snapshot struct {
+		schema sql.Schema
+		rows   []sql.Row
+	}
+	var snapshots = make(map[string]snapshot)
+	for _, row := range append([]db.Row{row}, rows...) {
+		var table = row.Row().Table()
+		if _, ok := snapshots[table]; ok {
+			continue
+		}
+		schema, rows, err := d.run(ctx, `SELECT * FROM `+cname(table))
+		if err != nil {
+			return err
+		}
+		snapshots[table] = snapshot{schema, rows}
+	}
 
-	q.WriteString(`INNER JOIN `)
-	q.WriteString(link.To.Table.Name)
-	q.WriteString(` ON `)
-	q.WriteString(link.From.Table.Name)
-	q.WriteString(`.`)
-	q.WriteString(link.From.Name)
-	q.WriteString(`=`)
-	q.WriteString(link.To.Table.Name)
-	q.WriteString(`.`)
-	q.WriteString(link.To.Name)
-	q.WriteString(` `)
+	var restore = func(err error) error {
+		for table, snapshot := range snapshots {
+			if restore := d.replace(ctx, table, snapshot.schema, snapshot.rows); restore != nil {
+				return restore
+			}
+		}
+		return err
+	}
 
-	return &q
+	if err := d.put(ctx, row, true, columns); err != nil {
+		return restore(err)
+	}
+	for _, row := range rows {
+		if err := d.put(ctx, row, true, columns); err != nil {
+			return restore(err)
+		}
+	}
+	return nil
 }
 
-//Link implements db.Driver.Link
-func (d Driver) Read(interface{}, ...interface{}) (int, error) {
-	panic("unimplemented")
+//drop drops the given table.
+//The caller must hold the engine's lock.
+func (e *engine) drop(table db.Table) error {
+	if _, _, err := e.run(context.Background(), `DROP TABLE `+cname(table.Table())); err != nil {
+		return err
+	}
+	delete(e.tables, table.Table())
+	e.wrote(table.Table())
+	return nil
 }
 
-//Delete implements db.Driver.Delete
+//Delete deletes the given tables.
 func (d Driver) Delete(table db.Table, tables ...db.Table) error {
-	_, _, err := query(d.Engine, d.Context, `DROP TABLE `+table.Name+`;`)
-	return err
+	d.Lock()
+	defer d.Unlock()
+
+	if err := d.drop(table); err != nil {
+		return err
+	}
+	for _, table := range tables {
+		if err := d.drop(table); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-//Truncate implements db.Driver.Truncate
-func (d Driver) Truncate(table db.Table, tables ...db.Table) error {
-	_, _, err := query(d.Engine, d.Context, `TRUNCATE TABLE `+table.Name+`;`)
-	return err
+//Empty removes all rows from the given tables so that they are empty.
+func (d Driver) Empty(table db.Table, tables ...db.Table) error {
+
+	d.Lock()
+	defer d.Unlock()
+
+	empty := func(table db.Table) error {
+		d.wrote(table.Table())
+		_, _, err := d.run(context.Background(), `DELETE FROM `+cname(table.Table()))
+		return err
+	}
+
+	if err := empty(table); err != nil {
+		return err
+	}
+	for _, table := range tables {
+		if err := empty(table); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//Search returns results for the given filter.
+func (d Driver) Search(filter db.Filter) db.Results {
+	var from, order strings.Builder
+	var values []interface{}
+
+	var joined = filter.Link.To != nil || len(filter.Links) > 0
+
+	from.WriteString("FROM " + cname(filter.Table))
+
+	addLink := func(link db.Linker) {
+		if link.To != nil {
+			from.WriteString(` INNER JOIN `)
+			from.WriteString(cname(link.To.Table()))
+			from.WriteString(` ON `)
+			from.WriteString(cname(link.From.Table()))
+			from.WriteString(`.`)
+			from.WriteString(cname(link.From.Column()))
+			from.WriteString(`=`)
+			from.WriteString(cname(link.To.Table()))
+			from.WriteString(`.`)
+			from.WriteString(cname(link.To.Column()))
+		}
+	}
+
+	if joined {
+		addLink(filter.Link)
+		for _, link := range filter.Links {
+			addLink(link)
+		}
+	}
+
+	var conditions = where.Writer{Dialect: where.MySQL, Joined: joined}
+	conditions.Where(filter)
+	values = conditions.Values
+
+	addSort := func(sort db.Sorter) {
+		if joined {
+			order.WriteString(cname(sort.Table))
+			order.WriteByte('.')
+		}
+		order.WriteString(cname(sort.Column))
+		if sort.Decreasing {
+			order.WriteString(` DESC`)
+		}
+	}
+
+	if len(filter.Groups) > 0 {
+		//Groups are ordered by the grouped columns.
+		for _, clause := range []string{" GROUP BY ", " ORDER BY "} {
+			order.WriteString(clause)
+			for i, group := range filter.Groups {
+				if i > 0 {
+					order.WriteString(",")
+				}
+				addSort(db.Sorter{Table: group.Table(), Column: group.Column()})
+			}
+		}
+	} else if filter.Sort.Column != "" {
+		order.WriteString(" ORDER BY ")
+		addSort(filter.Sort)
+		for _, sort := range filter.Sorts {
+			order.WriteString(",")
+			addSort(sort)
+		}
+	}
+
+	return results{
+		engine: d.engine,
+		ctx:    filter.Context(),
+
+		from:   from.String(),
+		where:  conditions.String(),
+		order:  order.String(),
+		values: values,
+
+		joined: joined,
+
+		table: filter.Table,
+		view:  filter.View,
+
+		length: filter.Length,
+		offset: filter.Offset,
+
+		columns: filter.Columns,
+
+		groups: filter.Groups,
+	}
+}
+
+//Close closes the connection to the database.
+func (d Driver) Close() error {
+	return nil
 }
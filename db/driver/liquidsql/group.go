@@ -0,0 +1,78 @@
+package liquidsql
+
+import (
+	"strings"
+
+	"qlova.store/db"
+)
+
+//functions are the SQL names of the aggregate functions.
+var functions = map[db.Function]string{
+	db.FnCount:   "COUNT",
+	db.FnSum:     "SUM",
+	db.FnAverage: "AVG",
+	db.FnMin:     "MIN",
+	db.FnMax:     "MAX",
+}
+
+//Aggregate reads the grouped columns and the aggregates of each group of the results into their variables.
+func (r results) Aggregate(aggregate db.Aggregate, aggregates ...db.Aggregate) (int, error) {
+	var query strings.Builder
+	query.WriteString(`SELECT `)
+
+	var all []db.Variable
+
+	for _, group := range r.groups {
+		if len(all) > 0 {
+			query.WriteByte(',')
+		}
+		r.column(&query, group.Table(), group.Column())
+		all = append(all, group)
+	}
+
+	for _, a := range append([]db.Aggregate{aggregate}, aggregates...) {
+		if len(all) > 0 {
+			query.WriteByte(',')
+		}
+		query.WriteString(functions[a.Function])
+		query.WriteByte('(')
+		if a.Function == db.FnCount {
+			query.WriteByte('*')
+		} else {
+			r.column(&query, a.Column.Table(), a.Column.Column())
+		}
+		query.WriteByte(')')
+		all = append(all, a.Into)
+	}
+
+	query.WriteByte(' ')
+	query.WriteString(r.from)
+	query.WriteString(r.where)
+	query.WriteString(r.order)
+
+	_, rows, err := r.query(r.ctx, query.String(), r.values...)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(rows) == 0 {
+		return 0, db.ErrNotFound
+	}
+
+	for _, variable := range all {
+		variable.Make(len(rows))
+	}
+
+	var pointers = make([]interface{}, len(all))
+	for index, row := range rows {
+		for i, variable := range all {
+			pointers[i] = variable.Slice(index)
+		}
+
+		if err := scan(row, pointers...); err != nil {
+			return 0, Error{err, query.String(), r.values}
+		}
+	}
+
+	return len(rows), nil
+}
@@ -1,286 +1,124 @@
 package liquidsql
 
 import (
-	"strings"
+	"context"
+	"database/sql/driver"
+	"io"
+	"sync"
+
+	sqle "github.com/liquidata-inc/go-mysql-server"
+	"github.com/liquidata-inc/go-mysql-server/memory"
+	"github.com/liquidata-inc/go-mysql-server/sql"
 
 	"qlova.store/db"
+	"qlova.store/db/driver/internal/reserved"
 )
 
-//Open sets the given connection to be backed by a mysql connection with the given options.
-func Open(connection db.Connection, cstring string) error {
-	return open(connection, "mysql", cstring)
+func init() {
+	db.Register("liquidsql", func(args ...string) (db.Driver, error) {
+		return Open(), nil
+	})
+}
+
+//Open returns a new in-memory SQL database, backed by go-mysql-server.
+//Each call to Open returns an independent database.
+func Open() db.Driver {
+	return Driver{newEngine()}
+}
+
+//engine is an in-memory go-mysql-server engine.
+type engine struct {
+	sync.Mutex
+	*sqle.Engine
+
+	pid uint64
+
+	//tables that have been synced with the engine.
+	tables map[string]db.Table
+
+	//versions counts the writes to each table, so that transactions can detect conflicting writes.
+	versions map[string]uint64
+
+	//began holds the versions of the tables of the parent engine when the transaction of this engine began.
+	began map[string]uint64
+}
+
+func newEngine() *engine {
+	e := sqle.NewDefault()
+	e.AddDatabase(memory.NewDatabase(""))
+	e.AddDatabase(sql.NewInformationSchemaDatabase(e.Catalog))
+
+	return &engine{
+		Engine:   e,
+		tables:   make(map[string]db.Table),
+		versions: make(map[string]uint64),
+	}
+}
+
+//wrote records a write to the named table.
+//The caller must hold the engine's lock.
+func (e *engine) wrote(table string) {
+	e.versions[table]++
 }
 
-func filterReservedWord(word string) string {
-	switch strings.ToUpper(word) {
-	case
-		"ACCESSIBLE",
-		"ADD",
-		"ALL",
-		"ALTER",
-		"ANALYZE",
-		"AND",
-		"AS",
-		"ASC",
-		"ASENSITIVE",
-		"BEFORE",
-		"BETWEEN",
-		"BIGINT",
-		"BINARY",
-		"BLOB",
-		"BOTH",
-		"BY",
-		"CALL",
-		"CASCADE",
-		"CASE",
-		"CHANGE",
-		"CHAR",
-		"CHARACTER",
-		"CHECK",
-		"COLLATE",
-		"COLUMN",
-		"CONDITION",
-		"CONSTRAINT",
-		"CONTINUE",
-		"CONVERT",
-		"CREATE",
-		"CROSS",
-		"CUBE",
-		"CUME_DIST",
-		"CURRENT_DATE",
-		"CURRENT_TIME",
-		"CURRENT_TIMESTAMP",
-		"CURRENT_USER",
-		"CURSOR",
-		"DATABASE",
-		"DATABASES",
-		"DAY_HOUR",
-		"DAY_MICROSECOND",
-		"DAY_MINUTE",
-		"DAY_SECOND",
-		"DEC",
-		"DECIMAL",
-		"DECLARE",
-		"DEFAULT",
-		"DELAYED",
-		"DELETE",
-		"DENSE_RANK",
-		"DESC",
-		"DESCRIBE",
-		"DETERMINISTIC",
-		"DISTINCT",
-		"DISTINCTROW",
-		"DIV",
-		"DOUBLE",
-		"DROP",
-		"DUAL",
-		"EACH",
-		"ELSE",
-		"ELSEIF",
-		"EMPTY",
-		"ENCLOSED",
-		"END",
-		"ESCAPED",
-		"EXCEPT",
-		"EXISTS",
-		"EXIT",
-		"EXPLAIN",
-		"FALSE",
-		"FETCH",
-		"FIRST_VALUE",
-		"FLOAT",
-		"FLOAT4",
-		"FLOAT8",
-		"FOR",
-		"FORCE",
-		"FOREIGN",
-		"FROM",
-		"FULLTEXT",
-		"FUNCTION",
-		"GENERATED",
-		"GET",
-		"GRANT",
-		"GROUP",
-		"GROUPING",
-		"GROUPS",
-		"HAVING",
-		"HIGH_PRIORITY",
-		"HOUR_MICROSECOND",
-		"HOUR_MINUTE",
-		"HOUR_SECOND",
-		"IF",
-		"IGNORE",
-		"IN",
-		"INDEX",
-		"INFILE",
-		"INNER",
-		"INOUT",
-		"INSENSITIVE",
-		"INSERT",
-		"INT",
-		"INT1",
-		"INT2",
-		"INT3",
-		"INT4",
-		"INT8",
-		"INTEGER",
-		"INTERVAL",
-		"INTO",
-		"IO_AFTER_GTIDS",
-		"IO_BEFORE_GTIDS",
-		"IS",
-		"ITERATE",
-		"JOIN",
-		"JSON_TABLE",
-		"KEY",
-		"KEYS",
-		"KILL",
-		"LAG",
-		"LAST_VALUE",
-		"LATERAL",
-		"LEAD",
-		"LEADING",
-		"LEAVE",
-		"LEFT",
-		"LIKE",
-		"LIMIT",
-		"LINEAR",
-		"LINES",
-		"LOAD",
-		"LOCALTIME",
-		"LOCALTIMESTAMP",
-		"LOCK",
-		"LONG",
-		"LONGBLOB",
-		"LONGTEXT",
-		"LOOP",
-		"LOW_PRIORITY",
-		"MASTER_BIND",
-		"MASTER_SSL_VERIFY_SERVER_CERT",
-		"MATCH",
-		"MAXVALUE",
-		"MEDIUMBLOB",
-		"MEDIUMINT",
-		"MEDIUMTEXT",
-		"MIDDLEINT",
-		"MINUTE_MICROSECOND",
-		"MINUTE_SECOND",
-		"MOD",
-		"MODIFIES",
-		"NATURAL",
-		"NOT",
-		"NO_WRITE_TO_BINLOG",
-		"NTH_VALUE",
-		"NTILE",
-		"NULL",
-		"NUMERIC",
-		"OF",
-		"ON",
-		"OPTIMIZE",
-		"OPTIMIZER_COSTS",
-		"OPTION",
-		"OPTIONALLY",
-		"OR",
-		"ORDER",
-		"OUT",
-		"OUTER",
-		"OUTFILE",
-		"OVER",
-		"PARTITION",
-		"PERCENT_RANK",
-		"PRECISION",
-		"PRIMARY",
-		"PROCEDURE",
-		"PURGE",
-		"RANGE",
-		"RANK",
-		"READ",
-		"READS",
-		"READ_WRITE",
-		"REAL",
-		"RECURSIVE",
-		"REFERENCES",
-		"REGEXP",
-		"RELEASE",
-		"RENAME",
-		"REPEAT",
-		"REPLACE",
-		"REQUIRE",
-		"RESIGNAL",
-		"RESTRICT",
-		"RETURN",
-		"REVOKE",
-		"RIGHT",
-		"RLIKE",
-		"ROW",
-		"ROWS",
-		"ROW_NUMBER",
-		"SCHEMA",
-		"SCHEMAS",
-		"SECOND_MICROSECOND",
-		"SELECT",
-		"SENSITIVE",
-		"SEPARATOR",
-		"SESSION",
-		"SET",
-		"SHOW",
-		"SIGNAL",
-		"SMALLINT",
-		"SPATIAL",
-		"SPECIFIC",
-		"SQL",
-		"SQLEXCEPTION",
-		"SQLSTATE",
-		"SQLWARNING",
-		"SQL_BIG_RESULT",
-		"SQL_CALC_FOUND_ROWS",
-		"SQL_SMALL_RESULT",
-		"SSL",
-		"START",
-		"STARTING",
-		"STORED",
-		"STRAIGHT_JOIN",
-		"SYSTEM",
-		"TABLE",
-		"TERMINATED",
-		"TEXT",
-		"THEN",
-		"TINYBLOB",
-		"TINYINT",
-		"TINYTEXT",
-		"TO",
-		"TRAILING",
-		"TRIGGER",
-		"TRUE",
-		"UNDO",
-		"UNION",
-		"UNIQUE",
-		"UNLOCK",
-		"UNSIGNED",
-		"UPDATE",
-		"USAGE",
-		"USE",
-		"USING",
-		"UTC_DATE",
-		"UTC_TIME",
-		"UTC_TIMESTAMP",
-		"VALUES",
-		"VARBINARY",
-		"VARCHAR",
-		"VARCHARACTER",
-		"VARYING",
-		"VIRTUAL",
-		"WHEN",
-		"WHERE",
-		"WHILE",
-		"WINDOW",
-		"WITH",
-		"WRITE",
-		"XOR",
-		"YEAR_MONTH",
-		"ZEROFILL":
+//run runs the given query with the given arguments and collects the resulting rows.
+//The caller must hold the engine's lock.
+func (e *engine) run(ctx context.Context, template string, args ...interface{}) (sql.Schema, []sql.Row, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
 
-		return strings.ToLower("`" + word + "`")
+	var converted = make([]driver.Value, len(args))
+	for i, arg := range args {
+		value, err := converter{}.ConvertValue(arg)
+		if err != nil {
+			return nil, nil, Error{err, template, args}
+		}
+		converted[i] = value
 	}
-	return word
+
+	q, err := prepare(template, converted)
+	if err != nil {
+		return nil, nil, Error{err, template, args}
+	}
+
+	e.pid++
+
+	schema, iter, err := e.Query(sql.NewContext(ctx, sql.WithPid(e.pid)), q)
+	if err != nil {
+		return nil, nil, Error{err, q, args}
+	}
+	defer iter.Close()
+
+	var rows []sql.Row
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+
+		row, err := iter.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, Error{err, q, args}
+		}
+
+		rows = append(rows, row)
+	}
+
+	return schema, rows, nil
+}
+
+//query locks the engine and runs the given query.
+func (e *engine) query(ctx context.Context, template string, args ...interface{}) (sql.Schema, []sql.Row, error) {
+	e.Lock()
+	defer e.Unlock()
+
+	return e.run(ctx, template, args...)
+}
+
+//cname returns the name of a column (or table) that is safe to use in a query.
+func cname(name string) string {
+	return reserved.MySQL(name)
 }
@@ -0,0 +1,121 @@
+package liquidsql_test
+
+import (
+	"testing"
+
+	"qlova.org/should"
+	"qlova.org/should/test"
+	"qlova.store/db"
+	"qlova.store/db/driver/liquidsql"
+)
+
+func Test_Open(t *testing.T) {
+	var driver = liquidsql.Open()
+
+	test.New(&db.TestSuite{
+		Driver: driver,
+	})(t)
+
+	should.NotError(driver.Close()).Test(t)
+}
+
+func Test_TransactionConflict(t *testing.T) {
+	var Testable db.TestablesViewer
+	var driver = liquidsql.Open().Connect(&Testable)
+	defer driver.Close()
+
+	should.NotError(db.Sync(Testable)).Test(t)
+
+	var row = Testable
+	row.ID.Set(1)
+	row.Value.Set("100")
+	should.NotError(db.Insert(row)).Test(t)
+
+	tx, err := driver.Begin()
+	should.NotError(err).Test(t)
+	defer tx.Close()
+
+	var Transacted = Testable
+	tx.Connect(&Transacted)
+
+	//A write outside of the transaction.
+	row = Testable
+	row.ID.Set(2)
+	row.Value.Set("outside")
+	should.NotError(db.Insert(row)).Test(t)
+
+	_, err = db.If(Transacted.ID.Equals(1)).Update(Transacted.Value.To("90"))
+	should.NotError(err).Test(t)
+
+	//The commit would lose the write made outside of the transaction, so it fails and nothing is committed.
+	should.Be(db.ErrTransactionConflict)(tx.Commit()).Test(t)
+	should.Be(db.ErrTransactionDone)(tx.Commit()).Test(t)
+
+	var result = Testable
+	should.NotError(db.If(Testable.ID.Equals(2)).Get(&result)).Test(t)
+	should.Be("outside")(result.Value.Value()).Test(t)
+
+	should.NotError(db.If(Testable.ID.Equals(1)).Get(&result)).Test(t)
+	should.Be("100")(result.Value.Value()).Test(t)
+
+	//Tables created and written to outside of the transaction are kept.
+	tx, err = driver.Begin()
+	should.NotError(err).Test(t)
+	defer tx.Close()
+
+	Transacted = Testable
+	tx.Connect(&Transacted)
+
+	_, err = db.If(Transacted.ID.Equals(1)).Update(Transacted.Value.To("90"))
+	should.NotError(err).Test(t)
+
+	var Linkable db.LinkablesViewer
+	driver.Connect(&Linkable)
+	should.NotError(db.Sync(Linkable)).Test(t)
+	defer db.Delete(&Linkable)
+
+	var link = Linkable
+	link.ID.Set(1)
+	link.Value.Set("outside")
+	should.NotError(db.Insert(link)).Test(t)
+
+	should.NotError(tx.Commit()).Test(t)
+
+	should.NotError(db.If(Testable.ID.Equals(1)).Get(&result)).Test(t)
+	should.Be("90")(result.Value.Value()).Test(t)
+
+	var linked = Linkable
+	should.NotError(db.If(Linkable.ID.Equals(1)).Get(&linked)).Test(t)
+	should.Be("outside")(linked.Value.Value()).Test(t)
+
+	should.NotError(db.Delete(&Testable)).Test(t)
+}
+
+func Test_TransactionInsert(t *testing.T) {
+	var Testable db.TestablesViewer
+	var driver = liquidsql.Open().Connect(&Testable)
+	defer driver.Close()
+
+	should.NotError(db.Sync(Testable)).Test(t)
+
+	tx, err := driver.Begin()
+	should.NotError(err).Test(t)
+	defer tx.Close()
+
+	var Transacted = Testable
+	tx.Connect(&Transacted)
+
+	//Rows of the same transaction are inserted together.
+	var first, second = Transacted, Transacted
+	first.ID.Set(1)
+	first.Value.Set("first")
+	second.ID.Set(2)
+	second.Value.Set("second")
+	should.NotError(db.Insert(first, second)).Test(t)
+
+	should.NotError(tx.Commit()).Test(t)
+
+	count, err := db.If(Testable.Value.NotEquals("")).Count(Testable.ID)
+	should.NotError(err).Test(t)
+	should.Be(2)(count).Test(t)
+}
@@ -343,8 +343,9 @@ func prepare(query string, args []driver.Value) (string, error) {
 			if v.IsZero() {
 				buf = append(buf, "'0000-00-00'"...)
 			} else {
+				var err error
 				buf = append(buf, '\'')
-				buf, err := appendDateTime(buf, v.In(time.Local))
+				buf, err = appendDateTime(buf, v.In(time.Local))
 				if err != nil {
 					return "", err
 				}
@@ -0,0 +1,387 @@
+package liquidsql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"qlova.store/db"
+)
+
+type results struct {
+	*engine
+	ctx context.Context
+
+	from, where, order string
+	values             []interface{}
+
+	joined bool
+
+	table string
+
+	view db.Table
+
+	offset, length int
+
+	columns []db.Variable
+
+	groups []db.Variable
+}
+
+//column writes the given column to the query.
+func (r results) column(query *strings.Builder, table, column string) {
+	if r.joined {
+		query.WriteString(cname(table))
+		query.WriteByte('.')
+	}
+	query.WriteString(cname(column))
+}
+
+//limit writes the LIMIT and OFFSET of the results to the query.
+func (r results) limit(query *strings.Builder) {
+	if r.length > 0 {
+		query.WriteString(` LIMIT `)
+		query.WriteString(strconv.Itoa(r.length))
+	}
+	if r.offset > 0 {
+		query.WriteString(` OFFSET `)
+		query.WriteString(strconv.Itoa(r.offset))
+	}
+}
+
+//MarshalJSON implements json.Marshaler
+func (r results) MarshalJSON() ([]byte, error) {
+	var query strings.Builder
+	query.WriteString(`SELECT `)
+
+	var columns []db.Column
+	if r.columns != nil {
+		for _, column := range r.columns {
+			columns = append(columns, column)
+		}
+	} else {
+		for i := 0; i < r.view.Columns(); i++ {
+			columns = append(columns, r.view.Column(i))
+		}
+	}
+
+	for i, column := range columns {
+		if i > 0 {
+			query.WriteByte(',')
+		}
+		var table = r.view.Table()
+		if viewable, ok := column.(db.Viewable); ok {
+			table = viewable.Table()
+		}
+		r.column(&query, table, column.Column())
+	}
+
+	query.WriteByte(' ')
+	query.WriteString(r.from)
+	query.WriteString(r.where)
+	query.WriteString(r.order)
+
+	_, rows, err := r.query(r.ctx, query.String(), r.values...)
+	if err != nil {
+		return nil, err
+	}
+
+	var buffer bytes.Buffer
+	buffer.WriteByte('[')
+
+	for index, row := range rows {
+		if index > 0 {
+			buffer.WriteByte(',')
+		}
+
+		buffer.WriteByte('{')
+		for i, column := range columns {
+			if i > 0 {
+				buffer.WriteByte(',')
+			}
+
+			buffer.WriteString(strconv.Quote(column.Column()))
+			buffer.WriteByte(':')
+
+			//Scan into the column's type so that the value is encoded the same way as the column.
+			var value = reflect.New(column.Type())
+			if err := convertAssign(value.Interface(), row[i]); err != nil {
+				return nil, Error{err, query.String(), r.values}
+			}
+
+			encoded, err := json.Marshal(value.Elem().Interface())
+			if err != nil {
+				return nil, err
+			}
+			buffer.Write(encoded)
+		}
+		buffer.WriteByte('}')
+	}
+
+	buffer.WriteByte(']')
+
+	return buffer.Bytes(), nil
+}
+
+//count returns the number of results.
+//The caller must hold the engine's lock.
+func (r results) count() (int, error) {
+	_, rows, err := r.run(r.ctx, `SELECT COUNT(*) `+r.from+r.where, r.values...)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+	if err := scan(rows[0], &count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+//Update updates the results with the given updates.
+//Returns the number of results updated.
+func (r results) Update(update db.Update, updates ...db.Update) (int, error) {
+	var query strings.Builder
+	var values []interface{}
+
+	query.WriteString(`UPDATE `)
+	query.WriteString(cname(r.table))
+	query.WriteString(` SET `)
+
+	var addupdate func(update db.Update)
+	addupdate = func(update db.Update) {
+		query.WriteString(cname(update.Column))
+		query.WriteString(`=?`)
+
+		values = append(values, update.Value)
+
+		if update.Then != nil {
+			query.WriteByte(',')
+			addupdate(*update.Then)
+		}
+	}
+
+	addupdate(update)
+	for _, update := range updates {
+		query.WriteByte(',')
+		addupdate(update)
+	}
+
+	query.WriteString(r.where)
+
+	r.Lock()
+	defer r.Unlock()
+
+	count, err := r.count()
+	if err != nil {
+		return 0, err
+	}
+
+	r.wrote(r.table)
+
+	//The engine does not enforce unique indexes, so the rows are restored if the update breaks one.
+	schema, rows, err := r.run(r.ctx, `SELECT * FROM `+cname(r.table))
+	if err != nil {
+		return 0, err
+	}
+
+	if _, _, err := r.run(r.ctx, query.String(), append(values, r.values...)...); err != nil {
+		return 0, err
+	}
+
+	if err := r.unique(r.ctx, r.table); err != nil {
+		if restore := r.replace(r.ctx, r.table, schema, rows); restore != nil {
+			return 0, restore
+		}
+		return 0, err
+	}
+
+	return count, nil
+}
+
+//Delete deletes all the results from the database.
+func (r results) Delete() (int, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	count, err := r.count()
+	if err != nil {
+		return 0, err
+	}
+
+	r.wrote(r.table)
+
+	if _, _, err := r.run(r.ctx, `DELETE `+r.from+r.where, r.values...); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+//Get gets the matching columns of the results.
+func (r results) Get(variable db.Variable, variables ...db.Variable) (int, error) {
+	var query strings.Builder
+	query.WriteString(`SELECT `)
+
+	r.column(&query, variable.Table(), variable.Column())
+	for _, variable := range variables {
+		query.WriteByte(',')
+		r.column(&query, variable.Table(), variable.Column())
+	}
+
+	query.WriteByte(' ')
+	query.WriteString(r.from)
+	query.WriteString(r.where)
+	query.WriteString(r.order)
+	r.limit(&query)
+
+	_, rows, err := r.query(r.ctx, query.String(), r.values...)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(rows) == 0 {
+		return 0, db.ErrNotFound
+	}
+
+	var pointers = make([]interface{}, len(variables)+1)
+
+	if r.length == 1 {
+		pointers[0] = variable.Pointer()
+		for i, variable := range variables {
+			pointers[i+1] = variable.Pointer()
+		}
+
+		if err := scan(rows[0], pointers...); err != nil {
+			return 0, Error{err, query.String(), r.values}
+		}
+
+		return 1, nil
+	}
+
+	variable.Make(len(rows))
+	for _, variable := range variables {
+		variable.Make(len(rows))
+	}
+
+	for index, row := range rows {
+		pointers[0] = variable.Slice(index)
+		for i, variable := range variables {
+			pointers[i+1] = variable.Slice(index)
+		}
+
+		if err := scan(row, pointers...); err != nil {
+			return 0, Error{err, query.String(), r.values}
+		}
+	}
+
+	return len(rows), nil
+}
+
+//Count returns the number of results.
+func (r results) Count(value db.Viewable) (int, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	return r.count()
+}
+
+//aggregate returns the result of the given aggregate expression on the given column, nil if there are no results.
+//The expression is a format string for the column, ie. MIN(%v)
+func (r results) aggregate(expression string, value db.Viewable) (interface{}, error) {
+	var column strings.Builder
+	r.column(&column, value.Table(), value.Column())
+
+	var query strings.Builder
+	query.WriteString(`SELECT `)
+	fmt.Fprintf(&query, expression, column.String())
+	query.WriteByte(' ')
+	query.WriteString(r.from)
+	query.WriteString(r.where)
+
+	_, rows, err := r.query(r.ctx, query.String(), r.values...)
+	if err != nil {
+		return nil, err
+	}
+
+	return rows[0][0], nil
+}
+
+//Sum returns the sum amount of the value in the given column of all results.
+func (r results) Sum(value db.Variable) error {
+	sum, err := r.aggregate("SUM(%v)", value)
+	if err != nil {
+		return err
+	}
+
+	if sum == nil {
+		var pointer = reflect.ValueOf(value.Pointer()).Elem()
+		pointer.Set(reflect.Zero(pointer.Type()))
+		return nil
+	}
+
+	return convertAssign(value.Pointer(), sum)
+}
+
+//Average returns the average value in the given column for all results.
+func (r results) Average(value db.Viewable) (float64, error) {
+	avg, err := r.aggregate("AVG(%v)", value)
+	if err != nil {
+		return 0, err
+	}
+
+	if avg == nil {
+		return 0, db.ErrNotFound
+	}
+
+	var result float64
+	if err := convertAssign(&result, avg); err != nil {
+		return 0, err
+	}
+
+	return result, nil
+}
+
+//extreme sets the variable to the result of MIN or MAX on its column.
+func (r results) extreme(function string, value db.Variable) error {
+	result, err := r.aggregate(function+"(%v)", value)
+	if err != nil {
+		return err
+	}
+
+	if result == nil {
+		return db.ErrNotFound
+	}
+
+	return convertAssign(value.Pointer(), result)
+}
+
+//Min sets the variable to the smallest value in its column for all results.
+func (r results) Min(value db.Variable) error {
+	return r.extreme("MIN", value)
+}
+
+//Max sets the variable to the largest value in its column for all results.
+func (r results) Max(value db.Variable) error {
+	return r.extreme("MAX", value)
+}
+
+//CountDistinct returns the number of distinct values in the given column for all results.
+func (r results) CountDistinct(value db.Viewable) (int, error) {
+	distinct, err := r.aggregate("COUNT(DISTINCT %v)", value)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+	if err := convertAssign(&count, distinct); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
@@ -0,0 +1,107 @@
+package liquidsql
+
+import (
+	"strings"
+
+	"github.com/liquidata-inc/go-mysql-server/sql"
+
+	"qlova.store/db"
+)
+
+//rows is a db.Rows over the rows returned by the engine.
+//The in-memory engine has already materialised the rows, so they are read from memory.
+type rows struct {
+	rows  []sql.Row
+	index int
+
+	query   string
+	columns []string
+}
+
+//Rows returns a cursor that reads the results one row at a time.
+func (r results) Rows() (db.Rows, error) {
+	var query strings.Builder
+	query.WriteString(`SELECT `)
+
+	var columns []string
+
+	addColumn := func(table, column string) {
+		if len(columns) > 0 {
+			query.WriteByte(',')
+		}
+		r.column(&query, table, column)
+		columns = append(columns, column)
+	}
+
+	if r.columns != nil {
+		for _, column := range r.columns {
+			addColumn(column.Table(), column.Column())
+		}
+	} else {
+		for i := 0; i < r.view.Columns(); i++ {
+			addColumn(r.view.Table(), r.view.Column(i).Column())
+		}
+	}
+
+	query.WriteByte(' ')
+	query.WriteString(r.from)
+	query.WriteString(r.where)
+	query.WriteString(r.order)
+	r.limit(&query)
+
+	_, result, err := r.query(r.ctx, query.String(), r.values...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rows{rows: result, query: query.String(), columns: columns}, nil
+}
+
+//Next advances to the next row, returning false when there are no more rows.
+func (r *rows) Next() bool {
+	if r.index >= len(r.rows) {
+		return false
+	}
+	r.index++
+	return true
+}
+
+//Scan reads the current row into the given viewer.
+//Columns that the viewer does not have are discarded.
+func (r *rows) Scan(v db.Viewer) error {
+	if v.Master() {
+		return db.ErrIllegalMaster
+	}
+	if r.index == 0 || r.index > len(r.rows) {
+		return db.ErrNotFound
+	}
+
+	var pointers = make([]interface{}, len(r.columns))
+	for i, name := range r.columns {
+		pointers[i] = new(interface{})
+
+		for j := 0; j < v.Columns(); j++ {
+			if column := v.Column(j); column.Column() == name {
+				pointers[i] = db.Mutate(v, column).Pointer()
+				break
+			}
+		}
+	}
+
+	if err := scan(r.rows[r.index-1], pointers...); err != nil {
+		return Error{err, r.query, nil}
+	}
+
+	return nil
+}
+
+//Err returns the error, if any, that was encountered during iteration.
+func (r *rows) Err() error {
+	return nil
+}
+
+//Close releases the rows.
+func (r *rows) Close() error {
+	r.rows = nil
+	return nil
+}
@@ -1,182 +1,286 @@
 package liquidsql
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/liquidata-inc/go-mysql-server/sql"
 
 	"qlova.store/db"
 )
 
-//TypeOf returns the SQL type of the given db.Value.
-func TypeOf(val db.Value) (string, error) {
-	switch val.(type) {
-	case db.Int:
-		return "int", nil
-	case db.String:
-		return "text", nil
-	case db.UUID:
-		return "varchar(36)", nil
-	case db.Bool:
-		return "boolean", nil
-	case db.Time:
-		return "timestamp", nil
-	case db.Float64:
-		return "double precision", nil
+//typeInfo returns the SQL type and default value of the given column type.
+func typeInfo(rtype reflect.Type) (tname string, tvalue string, err error) {
+	//Nullable columns default to NULL.
+	if rtype.Kind() == reflect.Ptr {
+		tname, _, err := typeInfo(rtype.Elem())
+		return tname, `NULL`, err
+	}
+
+	var zero = reflect.Zero(rtype).Interface()
+
+	switch zero.(type) {
+	case int8:
+		return "tinyint", `0`, nil
+	case int16:
+		return "smallint", `0`, nil
+	case int32:
+		return "int", `0`, nil
+	case int64:
+		return "bigint", `0`, nil
+
+	case float32:
+		return "float", `0`, nil
+	case float64:
+		return "double", `0`, nil
+
+	case string:
+		return "text", `''`, nil
+	case []byte:
+		return "blob", `''`, nil
+
+	case bool:
+		return "boolean", `false`, nil
+
+	case time.Time:
+		return "datetime", `'0001-01-01 00:00:00'`, nil
+	case uuid.UUID:
+		return "varchar(36)", `'00000000-0000-0000-0000-000000000000'`, nil
+	case db.Dec:
+		return "decimal(65,30)", `0`, nil
+	case db.Document:
+		return "json", `'null'`, nil
+
 	default:
-		return "", errors.New("sql: unsupported type: " + reflect.TypeOf(val).String())
+		return "", "", errors.New("unsupported liquidsql db data type: " + rtype.String())
 	}
 }
 
-//DefaultValueOf returns the SQL default value of the given db.Value.
-func DefaultValueOf(val db.Value) (string, error) {
-	switch val.(type) {
-	case db.Int:
-		return "0", nil
-	case db.String:
-		return `''`, nil
-	case db.UUID:
-		return "'00000000-00000000-00000000-00000000'", nil
-	case db.Bool:
-		return "false", nil
-	case db.Time:
-		return "0001-01-01 00:00:00", nil
-	case db.Float64:
-		return "0", nil
-	default:
-		return "", errors.New("sql: unsupported type: " + reflect.TypeOf(val).String())
+//columnType returns the SQL type and default value of the given column.
+func columnType(column db.Column) (tname string, dvalue string, err error) {
+	tname, dvalue, err = typeInfo(column.Type())
+	if err != nil {
+		return "", "", err
+	}
+
+	//Decimal columns can have a fixed precision and scale.
+	if p, ok := column.(interface{ Precision() int }); ok && strings.HasPrefix(tname, "decimal") && p.Precision() > 0 {
+		var scale int
+		if s, ok := column.(interface{ Scale() int }); ok {
+			scale = s.Scale()
+		}
+		tname = fmt.Sprintf("decimal(%v,%v)", p.Precision(), scale)
+	}
+
+	return tname, dvalue, nil
+}
+
+//nullability returns the NOT NULL constraint for columns of the given type, nullable columns have none.
+func nullability(rtype reflect.Type) string {
+	if rtype.Kind() == reflect.Ptr {
+		return ""
+	}
+	return " NOT NULL"
+}
+
+//kind returns the given type name the way that the engine reports it, without the precision of decimals.
+func kind(tname string) string {
+	tname = strings.ToLower(tname)
+	if tname == "boolean" {
+		return "tinyint"
+	}
+	if strings.HasPrefix(tname, "decimal(") {
+		return "decimal"
 	}
+	return tname
 }
 
-//Verify implements db.Driver.Verify
-func (d Driver) Verify(schema db.Schema) error {
+//plan returns the differences between the table and the engine, along with the statements that resolve them.
+//The caller must hold the engine's lock.
+func (e *engine) plan(table db.Table) (db.Diff, error) {
+	var diff db.Diff
 
-	var q Query
-	q.Driver = d
+	//The engine cannot enforce foreign keys.
+	if len(db.ForeignKeys(table)) > 0 {
+		return diff, db.ErrUnsupported
+	}
 
-	q.WriteString(`CREATE TABLE IF NOT EXISTS `)
-	q.WriteString(schema.Table.Name)
-	q.WriteString(` (`)
-	for i, val := range schema.Columns {
-		col := val.GetColumn()
+	if _, ok := e.tables[table.Table()]; !ok {
+		var query strings.Builder
+		var keys []string
 
-		t, err := TypeOf(val)
-		if err != nil {
-			return err
-		}
+		fmt.Fprintf(&query, `CREATE TABLE IF NOT EXISTS %v (`, cname(table.Table()))
+		for i := 0; i < table.Columns(); i++ {
+			column := table.Column(i)
 
-		col.Name = filterReservedWord(col.Name)
+			tname, _, err := columnType(column)
+			if err != nil {
+				return diff, err
+			}
 
-		q.WriteString(col.Name)
-		q.WriteByte(' ')
-		q.WriteString(t)
-		q.WriteString(" NOT NULL")
+			if column.Key() {
+				keys = append(keys, cname(column.Column()))
+			}
 
-		if i < len(schema.Columns)-1 {
-			q.WriteByte(',')
+			if i > 0 {
+				query.WriteByte(',')
+			}
+			fmt.Fprintf(&query, `%v %v%v`, cname(column.Column()), tname, nullability(column.Type()))
 		}
+		if len(keys) > 0 {
+			fmt.Fprintf(&query, `, PRIMARY KEY (%v)`, strings.Join(keys, ","))
+		}
+		query.WriteByte(')')
+
+		diff.Changes = append(diff.Changes, db.Change{Difference: db.MissingTable, Table: table.Table()})
+		diff.Statements = append(diff.Statements, query.String())
+
+		return diff, nil
 	}
-	q.WriteByte(')')
 
-	_, _, err := query(q.Driver.Engine, q.Driver.Context, q.String())
+	schema, _, err := e.run(context.Background(), `SELECT * FROM `+cname(table.Table())+` LIMIT 1`)
 	if err != nil {
-		return q.Error(err)
+		return diff, err
 	}
 
-	q.Builder = strings.Builder{}
-	fmt.Fprintf(&q, `select * from %v LIMIT 1`, schema.Table.Name)
-
-	columns, rows, err := query(q.Driver.Engine, q.Driver.Context, q.String())
-	if err != nil {
-		return q.Error(err)
-	}
-	rows.Close()
-
-	ExistingColumns := make(map[string]struct{}, len(columns))
-	for _, column := range columns {
-		ExistingColumns[strings.ToLower(column.Name)] = struct{}{}
-	}
-
-	//Query existing constraints.
-	/*q.Builder = strings.Builder{}
-	fmt.Fprintf(&q, `select
-		INFORMATION_SCHEMA.constraint_column_usage.column_name,
-		INFORMATION_SCHEMA.TABLE_CONSTRAINTS.constraint_type,
-		INFORMATION_SCHEMA.CHECK_CONSTRAINTS.check_clause
-		from INFORMATION_SCHEMA.TABLE_CONSTRAINTS
-		full outer join INFORMATION_SCHEMA.CHECK_CONSTRAINTS on
-		INFORMATION_SCHEMA.TABLE_CONSTRAINTS.constraint_name=INFORMATION_SCHEMA.CHECK_CONSTRAINTS.constraint_name
-		full outer join INFORMATION_SCHEMA.key_column_usage on
-		INFORMATION_SCHEMA.TABLE_CONSTRAINTS.constraint_name=INFORMATION_SCHEMA.key_column_usage.constraint_name
-		full outer join INFORMATION_SCHEMA.constraint_column_usage on
-		INFORMATION_SCHEMA.TABLE_CONSTRAINTS.constraint_name=INFORMATION_SCHEMA.constraint_column_usage.constraint_name
-		where INFORMATION_SCHEMA.TABLE_CONSTRAINTS.table_name='%v';
-	`, schema.Table.Name)
-
-	rows, err = q.Driver.DB.QueryContext(q.Driver.Context, q.String())
-	if err != nil {
-		return q.Error(err)
+	ExistingColumns := make(map[string]*sql.Column, len(schema))
+	var ExistingKeys []string
+	for _, column := range schema {
+		var name = strings.ToLower(column.Name)
+		ExistingColumns[name] = column
+		if column.PrimaryKey {
+			ExistingKeys = append(ExistingKeys, name)
+		}
 	}
 
-	ExistingConstraints := make(map[string]struct{})
-	for rows.Next() {
-		if err := rows.Err(); err != nil {
-			return err
+	//Ensure columns are in sync.
+	var keys []string
+	var columns = make(map[string]struct{}, table.Columns())
+	for i := 0; i < table.Columns(); i++ {
+		target := table.Column(i)
+
+		var column = strings.ToLower(target.Column())
+		columns[column] = struct{}{}
+
+		if target.Key() {
+			keys = append(keys, column)
 		}
-		var name, ctype, check *string
-		err := rows.Scan(&name, &ctype, &check)
+
+		tname, dvalue, err := columnType(target)
 		if err != nil {
-			return err
+			return diff, err
 		}
 
-		if name != nil {
-			ExistingConstraints[*name+" "+*ctype] = struct{}{}
-		} else if check != nil {
-			ExistingConstraints[*check] = struct{}{}
-		} else {
-			return errors.New("unknown constraint: " + *ctype)
+		if existing, ok := ExistingColumns[column]; ok {
+			if kind(existing.Type.String()) != kind(tname) {
+				diff.Changes = append(diff.Changes, db.Change{
+					Difference: db.TypeMismatch,
+					Table:      table.Table(),
+					Column:     target.Column(),
+					Want:       tname,
+					Have:       existing.Type.String(),
+				})
+			}
+			continue
 		}
-	}*/
-
-	//Ensure columns are in sync.
-	for _, value := range schema.Columns {
-		var target = value.GetColumn()
 
-		if _, ok := ExistingColumns[strings.ToLower(target.Name)]; ok {
+		diff.Changes = append(diff.Changes, db.Change{
+			Difference: db.MissingColumn,
+			Table:      table.Table(),
+			Column:     target.Column(),
+			Want:       tname,
+		})
+		diff.Statements = append(diff.Statements, fmt.Sprintf(`ALTER TABLE %v ADD %v %v%v DEFAULT %v`,
+			cname(table.Table()), cname(target.Column()), tname, nullability(target.Type()), dvalue))
+	}
 
-			/*for _, constraint := range target.Constraints {
-				constraint = strings.ToUpper(strings.TrimSpace(constraint))
+	sort.Strings(keys)
+	sort.Strings(ExistingKeys)
+	if strings.Join(keys, ",") != strings.Join(ExistingKeys, ",") {
+		diff.Changes = append(diff.Changes, db.Change{
+			Difference: db.KeyMismatch,
+			Table:      table.Table(),
+			Want:       strings.Join(keys, ","),
+			Have:       strings.Join(ExistingKeys, ","),
+		})
+	}
 
-				if constraint == "" {
-					continue
-				}
+	for _, column := range schema {
+		if _, ok := columns[strings.ToLower(column.Name)]; !ok {
+			diff.Changes = append(diff.Changes, db.Change{
+				Difference: db.ExtraColumn,
+				Table:      table.Table(),
+				Column:     column.Name,
+				Have:       column.Type.String(),
+			})
+		}
+	}
 
-				if constraint == "NOT NULL" {
-					constraint = "IS NOT NULL"
-				}
+	return diff, nil
+}
 
-				if _, ok := ExistingConstraints[target.Name+" "+constraint]; !ok {
-					return fmt.Errorf("constraint mismatch on column %v, missing constraint %v", target.Name, constraint)
-				}
-			}*/
+//sync creates the table if it doesn't exist and adds any missing columns.
+//If types or keys do not match up, an error is returned.
+//The caller must hold the engine's lock.
+func (e *engine) sync(table db.Table) error {
+	diff, err := e.plan(table)
+	if err != nil {
+		return err
+	}
+	if err := diff.Err(); err != nil {
+		return err
+	}
 
-			continue
+	for _, statement := range diff.Statements {
+		e.wrote(table.Table())
+		if _, _, err := e.run(context.Background(), statement); err != nil {
+			return err
 		}
+	}
 
-		t, _ := TypeOf(value)
-		defaultVal, _ := DefaultValueOf(value)
+	e.tables[table.Table()] = table
 
-		q.Builder = strings.Builder{}
+	return nil
+}
 
-		fmt.Fprintf(&q, `ALTER TABLE %v`+"\n"+`ADD %v %v %v`+"\n"+"DEFAULT %v", schema.Table.Name, target.Name, t, "NOT NULL", defaultVal)
+//Plan returns the changes that Sync would make to the database, without making them.
+func (d Driver) Plan(table db.Table, tables ...db.Table) (db.Diff, error) {
+	d.Lock()
+	defer d.Unlock()
 
-		_, _, err = query(q.Driver.Engine, q.Driver.Context, q.String())
+	diff, err := d.plan(table)
+	if err != nil {
+		return db.Diff{}, err
+	}
+	for _, table := range tables {
+		next, err := d.plan(table)
 		if err != nil {
-			return q.Error(err)
+			return db.Diff{}, err
 		}
+		diff.Changes = append(diff.Changes, next.Changes...)
+		diff.Statements = append(diff.Statements, next.Statements...)
 	}
+	return diff, nil
+}
 
-	return nil
+//Sync syncs the Tables with the Database, adding any missing columns.
+func (d Driver) Sync(table db.Table, tables ...db.Table) error {
+	d.Lock()
+	defer d.Unlock()
 
+	if err := d.sync(table); err != nil {
+		return err
+	}
+	for _, table := range tables {
+		if err := d.sync(table); err != nil {
+			return err
+		}
+	}
+	return nil
 }
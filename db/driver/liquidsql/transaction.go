@@ -0,0 +1,205 @@
+package liquidsql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/liquidata-inc/go-mysql-server/sql"
+
+	"qlova.store/db"
+)
+
+//transaction is a copy-on-write transaction.
+//The synced tables of the database are copied into a separate engine,
+//on commit, the tables of the database that were written to inside of the transaction are replaced with the tables of the transaction.
+type transaction struct {
+	Driver
+
+	parent *engine
+
+	done *bool
+}
+
+//copyTable copies the rows of the named table from one engine to another, replacing any existing rows.
+//The caller must hold the lock of both engines.
+func copyTable(ctx context.Context, from, to *engine, table string) error {
+	schema, rows, err := from.run(ctx, `SELECT * FROM `+cname(table))
+	if err != nil {
+		return err
+	}
+
+	return to.replace(ctx, table, schema, rows)
+}
+
+//replace replaces the rows of the named table with the given rows.
+//The caller must hold the engine's lock.
+func (e *engine) replace(ctx context.Context, table string, schema sql.Schema, rows []sql.Row) error {
+	e.wrote(table)
+
+	if _, _, err := e.run(ctx, `DELETE FROM `+cname(table)); err != nil {
+		return err
+	}
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	var columns = make([]string, len(schema))
+	for i, column := range schema {
+		columns[i] = cname(column.Name)
+	}
+
+	var insert = `INSERT INTO ` + cname(table) + ` (` + strings.Join(columns, ",") + `) VALUES (` + placeholders(len(columns)) + `)`
+
+	for _, row := range rows {
+		if _, _, err := e.run(ctx, insert, row...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//BeginContext starts a transaction, unless the given context is done.
+func (d Driver) BeginContext(ctx context.Context) (db.Tx, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return d.Begin()
+}
+
+//Begin starts a transaction.
+func (d Driver) Begin() (db.Tx, error) {
+	d.Lock()
+	defer d.Unlock()
+
+	var clone = newEngine()
+	var ctx = context.Background()
+
+	for name, table := range d.tables {
+		if err := clone.sync(table); err != nil {
+			return nil, err
+		}
+		if err := copyTable(ctx, d.engine, clone, name); err != nil {
+			return nil, err
+		}
+	}
+
+	//Only writes made inside of the transaction count.
+	clone.versions = make(map[string]uint64)
+
+	clone.began = make(map[string]uint64, len(d.versions))
+	for name, version := range d.versions {
+		clone.began[name] = version
+	}
+
+	return transaction{Driver{clone}, d.engine, new(bool)}, nil
+}
+
+//Connect connects the given viewer to view this transaction.
+//It then returns the transaction.
+func (t transaction) Connect(first db.Viewer, more ...db.Viewer) db.Driver {
+	db.Connect(first, t)
+	for _, viewer := range more {
+		db.Connect(viewer, t)
+	}
+	return t
+}
+
+//Begin returns db.ErrNestedTransaction.
+func (t transaction) Begin() (db.Tx, error) {
+	return nil, db.ErrNestedTransaction
+}
+
+//BeginContext returns db.ErrNestedTransaction.
+func (t transaction) BeginContext(context.Context) (db.Tx, error) {
+	return nil, db.ErrNestedTransaction
+}
+
+//Commit replaces the tables of the database that were written to inside of this transaction with the tables of this transaction.
+//If any of these tables has been written to outside of the transaction since it began, the commit fails with db.ErrTransactionConflict.
+//Either all of the tables are replaced or, if the commit fails, none of them are.
+func (t transaction) Commit() error {
+	t.parent.Lock()
+	defer t.parent.Unlock()
+	t.Lock()
+	defer t.Unlock()
+
+	if *t.done {
+		return db.ErrTransactionDone
+	}
+	*t.done = true
+
+	//The copies would overwrite any writes made outside of the transaction.
+	for name := range t.engine.versions {
+		if t.parent.versions[name] != t.engine.began[name] {
+			return db.ErrTransactionConflict
+		}
+	}
+
+	if len(t.engine.versions) == 0 {
+		return nil
+	}
+
+	var ctx = context.Background()
+
+	//The tables are copied into a new engine that replaces the engine of the database once every table has been copied,
+	//so that the database is left as it is if the commit fails.
+	var next = newEngine()
+
+	for name, table := range t.parent.tables {
+		if _, ok := t.engine.versions[name]; ok {
+			continue
+		}
+		if err := next.sync(table); err != nil {
+			return err
+		}
+		if err := copyTable(ctx, t.parent, next, name); err != nil {
+			return err
+		}
+	}
+
+	//Tables that were deleted inside of the transaction are left out.
+	for name := range t.engine.versions {
+		table, ok := t.tables[name]
+		if !ok {
+			continue
+		}
+		if err := next.sync(table); err != nil {
+			return err
+		}
+		if err := copyTable(ctx, t.engine, next, name); err != nil {
+			return err
+		}
+	}
+
+	for name := range t.engine.versions {
+		t.parent.wrote(name)
+	}
+
+	t.parent.Engine = next.Engine
+	t.parent.tables = next.tables
+
+	return nil
+}
+
+//Rollback discards the changes made by this transaction.
+func (t transaction) Rollback() error {
+	t.Lock()
+	defer t.Unlock()
+
+	if *t.done {
+		return db.ErrTransactionDone
+	}
+	*t.done = true
+
+	return nil
+}
+
+//Close rolls back the transaction if it hasn't been committed.
+func (t transaction) Close() error {
+	if err := t.Rollback(); err != nil && err != db.ErrTransactionDone {
+		return err
+	}
+	return nil
+}
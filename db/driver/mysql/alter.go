@@ -0,0 +1,39 @@
+package mysql
+
+import (
+	"qlova.store/db"
+)
+
+//RenameColumn renames a column of the named table.
+//Like any other change to the schema, this commits the current transaction in MySQL.
+func (d driver) RenameColumn(table, from, to string) error {
+	return d.alter(`ALTER TABLE ` + cname(table) + ` RENAME COLUMN ` + cname(from) + ` TO ` + cname(to))
+}
+
+//DropColumn removes a column, along with its values, from the named table.
+//Like any other change to the schema, this commits the current transaction in MySQL.
+func (d driver) DropColumn(table, column string) error {
+	return d.alter(`ALTER TABLE ` + cname(table) + ` DROP COLUMN ` + cname(column))
+}
+
+//AlterColumn changes the column of the named table with the same name as the given column to the type of the given column.
+//Existing values are converted to the new type.
+//Like any other change to the schema, this commits the current transaction in MySQL.
+func (d driver) AlterColumn(table string, column db.Column) error {
+	definition, err := definition(column)
+	if err != nil {
+		return err
+	}
+	return d.alter(`ALTER TABLE ` + cname(table) + ` MODIFY COLUMN ` + definition)
+}
+
+//alter executes the given statement that alters a table.
+func (d driver) alter(query string) error {
+	if d.error != nil {
+		return d.error
+	}
+	if _, err := d.Exec(query); err != nil {
+		return Error{err, query}
+	}
+	return nil
+}
@@ -0,0 +1,415 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	gomysql "github.com/go-sql-driver/mysql"
+	"qlova.store/db"
+	"qlova.store/db/driver/internal/where"
+)
+
+//executor is implemented by *sql.DB and *sql.Tx.
+type executor interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+//Driver is a db driver for MySQL databases.
+type driver struct {
+	executor
+
+	db *sql.DB
+	error
+}
+
+//Connect connects the given viewer to view this database.
+//It then returns the database.
+func (d driver) Connect(first db.Viewer, more ...db.Viewer) db.Driver {
+
+	connect := func(v db.Viewer) {
+		db.Connect(v, d)
+	}
+
+	connect(first)
+	for _, viewer := range more {
+		connect(viewer)
+	}
+	return d
+}
+
+//Insert inserts the given row into the database.
+func (d driver) Insert(row db.Row, rows ...db.Row) error {
+	return d.InsertContext(context.Background(), row, rows...)
+}
+
+//put inserts the given row into the database.
+func (d driver) put(ctx context.Context, row db.Row) error {
+	var insert db.Insertion
+	if err := insert.Row(row); err != nil {
+		return err
+	}
+
+	var query strings.Builder
+	query.WriteString(`INSERT INTO `)
+	query.WriteString(cname(row.Row().Table()))
+	query.WriteString(` (`)
+
+	//Auto columns are left out, so that MySQL generates them.
+	var values []interface{}
+	var generated = -1
+
+	for i, column := range insert.Columns {
+		if insert.Autos[i] {
+			generated = i
+			continue
+		}
+		if len(values) > 0 {
+			query.WriteByte(',')
+		}
+		query.WriteString(cname(column))
+		values = append(values, insert.Values[i])
+	}
+
+	query.WriteString(`) VALUES (`)
+
+	for i := range values {
+		if i > 0 {
+			query.WriteByte(',')
+		}
+		query.WriteByte('?')
+	}
+
+	query.WriteString(`)`)
+
+	result, err := d.ExecContext(ctx, query.String(), values...)
+	if err != nil {
+		if isDuplicate(err) {
+			return db.ErrDuplicateKey
+		}
+		if isForeignKey(err) {
+			return db.ErrForeignKey
+		}
+		return Error{err, query.String()}
+	}
+
+	if generated >= 0 {
+		id, err := result.LastInsertId()
+		if err != nil {
+			return Error{err, query.String()}
+		}
+		if id != 0 {
+			insert.Generated(generated, id)
+		}
+	}
+
+	return nil
+}
+
+//upsert inserts the given row into the database, or overwrites the given columns of the existing row with the same key.
+//ON DUPLICATE KEY UPDATE would also overwrite a row that only has the same value in a unique index,
+//so the row with the same key is looked up and updated instead. Other duplicates are rejected, as they are by the other drivers.
+func (d driver) upsert(ctx context.Context, row db.Row, columns []string) error {
+	var insert db.Insertion
+	if err := insert.Row(row); err != nil {
+		return err
+	}
+
+	var keys []string
+	var values []interface{}
+	for i, column := range insert.Columns {
+		if insert.Uniques[i] {
+			keys = append(keys, cname(column)+`=?`)
+			values = append(values, insert.Values[i])
+		}
+	}
+
+	if len(keys) == 0 {
+		return d.put(ctx, row)
+	}
+
+	var table = cname(insert.Table.Table())
+	var where = ` WHERE ` + strings.Join(keys, ` AND `)
+
+	return d.atomic(ctx, func(d driver) error {
+		var count int64
+		var query = `SELECT COUNT(*) FROM ` + table + where + ` FOR UPDATE`
+		if err := d.QueryRowContext(ctx, query, values...).Scan(&count); err != nil {
+			return Error{err, query}
+		}
+
+		if count == 0 {
+			return d.put(ctx, row)
+		}
+
+		var overwrites = insert.Overwrites(columns)
+		if len(overwrites) == 0 {
+			return nil
+		}
+
+		var assignments = make([]string, len(overwrites))
+		var arguments = make([]interface{}, 0, len(overwrites)+len(values))
+		for i, j := range overwrites {
+			assignments[i] = cname(insert.Columns[j]) + `=?`
+			arguments = append(arguments, insert.Values[j])
+		}
+		arguments = append(arguments, values...)
+
+		query = `UPDATE ` + table + ` SET ` + strings.Join(assignments, ",") + where
+		if _, err := d.ExecContext(ctx, query, arguments...); err != nil {
+			if isDuplicate(err) {
+				return db.ErrDuplicateKey
+			}
+			if isForeignKey(err) {
+				return db.ErrForeignKey
+			}
+			return Error{err, query}
+		}
+		return nil
+	})
+}
+
+//InsertContext inserts the given row into the database with the given context.
+//Multiple rows are inserted atomically.
+func (d driver) InsertContext(ctx context.Context, row db.Row, rows ...db.Row) error {
+	if d.error != nil {
+		return d.error
+	}
+
+	if len(rows) == 0 {
+		return d.put(ctx, row)
+	}
+
+	return d.atomic(ctx, func(d driver) error {
+		if err := d.put(ctx, row); err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if err := d.put(ctx, row); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+//atomic calls f with a driver inside of a transaction, either the one the driver is already in,
+//or a new one that is committed if f succeeds.
+func (d driver) atomic(ctx context.Context, f func(driver) error) error {
+	if _, ok := d.executor.(*sql.Tx); ok {
+		return f(d)
+	}
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := f(driver{tx, d.db, nil}); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+//UpsertContext inserts the given row into the database with the given context.
+//If a row with the same key already exists, the given columns of that row are overwritten instead (all columns if none are given).
+//Multiple rows are upserted atomically.
+func (d driver) UpsertContext(ctx context.Context, columns []string, row db.Row, rows ...db.Row) error {
+	if d.error != nil {
+		return d.error
+	}
+
+	if len(rows) == 0 {
+		return d.upsert(ctx, row, columns)
+	}
+
+	return d.atomic(ctx, func(d driver) error {
+		if err := d.upsert(ctx, row, columns); err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if err := d.upsert(ctx, row, columns); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+//Delete deletes the given tables.
+func (d driver) Delete(table db.Table, tables ...db.Table) error {
+	if d.error != nil {
+		return d.error
+	}
+
+	delete := func(table db.Table) error {
+		_, err := d.Exec(`DROP TABLE ` + cname(table.Table()))
+		return err
+	}
+
+	if err := delete(table); err != nil {
+		return err
+	}
+	for _, table := range tables {
+		if err := delete(table); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//Empty removes all rows from the given tables so that they are empty.
+func (d driver) Empty(table db.Table, tables ...db.Table) error {
+	if d.error != nil {
+		return d.error
+	}
+
+	empty := func(table db.Table) error {
+		_, err := d.Exec(`DELETE FROM ` + cname(table.Table()))
+		return err
+	}
+
+	if err := empty(table); err != nil {
+		return err
+	}
+	for _, table := range tables {
+		if err := empty(table); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//Search returns results for the given filter.
+func (d driver) Search(filter db.Filter) db.Results {
+	var query strings.Builder
+	var values []interface{}
+
+	var joined = filter.Link.To != nil || len(filter.Links) > 0
+
+	query.WriteString("FROM " + cname(filter.Table))
+
+	addLink := func(link db.Linker) {
+		if link.To != nil {
+			fmt.Fprintf(&query, ` INNER JOIN %v ON %v.%v=%v.%v`,
+				cname(link.To.Table()),
+				cname(link.From.Table()), cname(link.From.Column()),
+				cname(link.To.Table()), cname(link.To.Column()),
+			)
+		}
+	}
+
+	if joined {
+		addLink(filter.Link)
+		for _, link := range filter.Links {
+			addLink(link)
+		}
+	}
+
+	var conditions = where.Writer{Dialect: where.MySQL, Joined: joined}
+	conditions.Where(filter)
+	query.WriteString(conditions.String())
+	values = conditions.Values
+
+	addSort := func(sort db.Sorter) {
+		if sort.Column != "" {
+			if joined {
+				query.WriteString(cname(sort.Table))
+				query.WriteByte('.')
+			}
+			query.WriteString(cname(sort.Column))
+			if sort.Decreasing {
+				query.WriteString(` DESC`)
+			}
+		}
+	}
+
+	if len(filter.Groups) > 0 {
+		//Groups are ordered by the grouped columns.
+		for _, clause := range []string{" GROUP BY ", " ORDER BY "} {
+			query.WriteString(clause)
+			for i, group := range filter.Groups {
+				if i > 0 {
+					query.WriteString(",")
+				}
+				addSort(db.Sorter{Table: group.Table(), Column: group.Column()})
+			}
+		}
+	} else if filter.Sort.Column != "" {
+		query.WriteString(" ORDER BY ")
+		addSort(filter.Sort)
+		for _, sort := range filter.Sorts {
+			query.WriteString(",")
+			addSort(sort)
+		}
+	}
+
+	return results{
+		my:     d,
+		ctx:    filter.Context(),
+		query:  query.String(),
+		values: values,
+
+		joined: joined,
+
+		table: filter.Table,
+
+		view: filter.View,
+
+		length: filter.Length,
+		offset: filter.Offset,
+
+		columns: filter.Columns,
+
+		groups: filter.Groups,
+	}
+}
+
+//isDuplicate reports whether the given error is a duplicate key error.
+func isDuplicate(err error) bool {
+	e, ok := err.(*gomysql.MySQLError)
+	return ok && e.Number == 1062
+}
+
+//isForeignKey reports whether the given error is a foreign key violation, either of a parent row or a child row.
+func isForeignKey(err error) bool {
+	e, ok := err.(*gomysql.MySQLError)
+	return ok && (e.Number == 1451 || e.Number == 1452)
+}
+
+//Begin starts a transaction.
+func (d driver) Begin() (db.Tx, error) {
+	return d.BeginContext(context.Background())
+}
+
+//BeginContext starts a transaction with the given context.
+//The transaction is rolled back if the context is done before the transaction is committed.
+func (d driver) BeginContext(ctx context.Context) (db.Tx, error) {
+	if d.error != nil {
+		return nil, d.error
+	}
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return transaction{driver{tx, d.db, nil}, tx}, nil
+}
+
+//Close closes the connection to the database.
+func (d driver) Close() error {
+	if d.db == nil {
+		return d.error
+	}
+	return d.db.Close()
+}
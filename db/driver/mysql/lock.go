@@ -0,0 +1,43 @@
+package mysql
+
+import (
+	"context"
+	"errors"
+)
+
+//Lock blocks until the named lock is acquired, the returned function releases it.
+//The lock is held by a dedicated connection, so it is shared with every other connection to the database.
+func (d driver) Lock(ctx context.Context, name string) (func() error, error) {
+	if d.error != nil {
+		return nil, d.error
+	}
+
+	conn, err := d.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	const query = `SELECT GET_LOCK(?, -1)`
+
+	var acquired *int64
+	if err := conn.QueryRowContext(ctx, query, name).Scan(&acquired); err != nil {
+		conn.Close()
+		return nil, Error{err, query}
+	}
+	if acquired == nil || *acquired != 1 {
+		conn.Close()
+		return nil, errors.New("mysql: could not acquire lock " + name)
+	}
+
+	return func() error {
+		const query = `SELECT RELEASE_LOCK(?)`
+		_, err := conn.ExecContext(context.Background(), query, name)
+		if closeErr := conn.Close(); err == nil && closeErr != nil {
+			return closeErr
+		}
+		if err != nil {
+			return Error{err, query}
+		}
+		return nil
+	}, nil
+}
@@ -0,0 +1,195 @@
+package mysql
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	gomysql "github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+
+	"qlova.store/db"
+	"qlova.store/db/driver/internal/reserved"
+)
+
+func init() {
+	db.Register("mysql", open)
+}
+
+//dsn returns the given data source name with the options required by the driver.
+func dsn(connection string) (string, error) {
+	config, err := gomysql.ParseDSN(connection)
+	if err != nil {
+		return "", err
+	}
+
+	config.ParseTime = true
+
+	return config.FormatDSN(), nil
+}
+
+//open opens a mysql database for db.Open, the arguments are joined together to form the data source name.
+//The connection is verified before returning.
+func open(args ...string) (db.Driver, error) {
+	connection, err := dsn(strings.TrimPrefix(strings.Join(args, ""), "mysql://"))
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := sql.Open("mysql", connection)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Ping(); err != nil {
+		d.Close()
+		return nil, err
+	}
+
+	return driver{d, d, nil}, nil
+}
+
+//Open sets the given connection to be backed by a mysql connection with the given data source name.
+//ie. "user:password@tcp(localhost:3306)/database"
+func Open(connection string) db.Driver {
+	connection, err := dsn(connection)
+	if err != nil {
+		return driver{error: err}
+	}
+
+	d, err := sql.Open("mysql", connection)
+
+	return driver{d, d, err}
+}
+
+//Error wraps an error and a query.
+type Error struct {
+	error
+	Query string
+}
+
+//Error returns an error string.
+func (err Error) Error() string {
+	return fmt.Sprintf("%v: %v", err.error.Error(), err.Query)
+}
+
+//Unwrap returns the internal error of the Error.
+func (err Error) Unwrap() error {
+	return err.error
+}
+
+//typeInfo returns the mysql type of the given column type and its default value.
+//Types without a default value return an empty default.
+func typeInfo(rtype reflect.Type, key bool) (tname string, tvalue string, err error) {
+	//Nullable columns default to NULL.
+	if rtype.Kind() == reflect.Ptr {
+		tname, _, err := typeInfo(rtype.Elem(), key)
+		return tname, `NULL`, err
+	}
+
+	var zero = reflect.Zero(rtype).Interface()
+
+	switch zero.(type) {
+	case int8:
+		return "tinyint", `0`, nil
+	case int16:
+		return "smallint", `0`, nil
+	case int32:
+		return "int", `0`, nil
+	case int64:
+		return "bigint", `0`, nil
+
+	case float32:
+		return "float", `0`, nil
+	case float64:
+		return "double", `0`, nil
+
+	case string:
+		//text columns can neither be indexed nor have a default.
+		if key {
+			return "varchar(255)", `''`, nil
+		}
+		return "text", ``, nil
+	case []byte:
+		return "longblob", ``, nil
+
+	case bool:
+		return "boolean", `false`, nil
+
+	case time.Time:
+		return "datetime(6)", `'0001-01-01 00:00:00'`, nil
+	case uuid.UUID:
+		return "char(36)", `'00000000-0000-0000-0000-000000000000'`, nil
+	case db.Dec:
+		//MySQL defaults to decimal(10,0), which has no decimal places.
+		return "decimal(65,30)", `0`, nil
+	case db.Document:
+		//json columns cannot have a default.
+		return "json", ``, nil
+
+	default:
+		return "", "", errors.New("unsupported mysql db data type: " + rtype.String())
+	}
+}
+
+//indexed returns true if the column is a key, part of an index or a foreign key, these columns need a type that MySQL can index.
+func indexed(column db.Column) bool {
+	if column.Key() {
+		return true
+	}
+	if r, ok := column.(interface{ References() string }); ok && r.References() != "" {
+		return true
+	}
+	i, ok := column.(interface{ Indexed() bool })
+	return ok && i.Indexed()
+}
+
+//columnType returns the type name and default value of the given column.
+func columnType(column db.Column) (tname string, dvalue string, err error) {
+	tname, dvalue, err = typeInfo(column.Type(), indexed(column))
+	if err != nil {
+		return "", "", err
+	}
+
+	//Decimal columns can have a fixed precision and scale.
+	if p, ok := column.(interface{ Precision() int }); ok && strings.HasPrefix(tname, "decimal") && p.Precision() > 0 {
+		var scale int
+		if s, ok := column.(interface{ Scale() int }); ok {
+			scale = s.Scale()
+		}
+		tname = fmt.Sprintf("decimal(%v,%v)", p.Precision(), scale)
+	}
+
+	return tname, dvalue, nil
+}
+
+//definition returns the definition of the given column.
+func definition(column db.Column) (string, error) {
+	tname, dvalue, err := columnType(column)
+	if err != nil {
+		return "", err
+	}
+
+	var definition = cname(column.Column()) + " " + tname
+	if column.Type().Kind() != reflect.Ptr {
+		definition += " NOT NULL"
+	}
+
+	//Auto columns are generated by MySQL and cannot have a default.
+	if auto, ok := column.(interface{ Auto() bool }); ok && auto.Auto() {
+		return definition + " AUTO_INCREMENT", nil
+	}
+
+	if dvalue != "" {
+		definition += " DEFAULT " + dvalue
+	}
+
+	return definition, nil
+}
+
+func cname(name string) string {
+	return reserved.MySQL(name)
+}
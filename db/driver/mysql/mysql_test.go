@@ -0,0 +1,81 @@
+package mysql_test
+
+import (
+	"net"
+	"testing"
+
+	sqle "github.com/liquidata-inc/go-mysql-server"
+	"github.com/liquidata-inc/go-mysql-server/auth"
+	"github.com/liquidata-inc/go-mysql-server/memory"
+	"github.com/liquidata-inc/go-mysql-server/server"
+	"github.com/liquidata-inc/go-mysql-server/sql"
+
+	"qlova.org/should"
+	"qlova.org/should/test"
+	"qlova.store/db"
+	"qlova.store/db/driver/mysql"
+)
+
+//listen starts a go-mysql-server on a free localhost port and returns its address.
+func listen(t *testing.T) (string, func()) {
+	listener, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	address := listener.Addr().String()
+	listener.Close()
+
+	engine := sqle.NewDefault()
+	engine.AddDatabase(memory.NewDatabase("test"))
+	engine.AddDatabase(sql.NewInformationSchemaDatabase(engine.Catalog))
+
+	s, err := server.NewDefaultServer(server.Config{
+		Protocol: "tcp",
+		Address:  address,
+		Auth:     auth.NewNativeSingle("root", "", auth.AllPermissions),
+	}, engine)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	go s.Start()
+
+	return address, func() { s.Close() }
+}
+
+func Test_Open(t *testing.T) {
+	address, stop := listen(t)
+	defer stop()
+
+	var driver = mysql.Open("root:@tcp(" + address + ")/test")
+
+	test.New(&db.TestSuite{
+		Driver: driver,
+	})(t)
+
+	should.NotError(driver.Close()).Test(t)
+}
+
+func Test_ForeignKeys(t *testing.T) {
+	address, stop := listen(t)
+	defer stop()
+
+	var Parentable struct {
+		db.View `db:"parentable"`
+
+		ID db.Int64 `db:",key"`
+	}
+	var Childable struct {
+		db.View `db:"childable"`
+
+		ID     db.Int64 `db:",key"`
+		Parent db.Int64 `db:",references=parentable.id"`
+	}
+
+	var driver = mysql.Open("root:@tcp("+address+")/test").Connect(&Parentable, &Childable)
+	defer driver.Close()
+
+	//go-mysql-server does not enforce foreign keys, so the driver refuses to sync them.
+	should.NotError(db.Sync(Parentable)).Test(t)
+	should.Be(db.ErrUnsupported)(db.Sync(Childable)).Test(t)
+}
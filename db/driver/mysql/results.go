@@ -0,0 +1,417 @@
+package mysql
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"qlova.store/db"
+)
+
+type results struct {
+	my     driver
+	ctx    context.Context
+	query  string
+	values []interface{}
+
+	joined bool
+
+	table string
+
+	view db.Table
+
+	offset, length int
+
+	columns []db.Variable
+
+	groups []db.Variable
+}
+
+//column writes the name of the given column to the query.
+func (r results) column(query *strings.Builder, table, column string) {
+	if r.joined {
+		query.WriteString(cname(table))
+		query.WriteByte('.')
+	}
+	query.WriteString(cname(column))
+}
+
+//limit writes the LIMIT clause of the results to the query.
+func (r results) limit(query *strings.Builder) {
+	if r.length > 0 {
+		fmt.Fprintf(query, ` LIMIT %v,%v`, r.offset, r.length)
+	} else if r.offset > 0 {
+		//MySQL has no OFFSET without a LIMIT.
+		fmt.Fprintf(query, ` LIMIT %v,18446744073709551615`, r.offset)
+	}
+}
+
+//MarshalJSON implements json.Marshaler
+func (r results) MarshalJSON() ([]byte, error) {
+	var query strings.Builder
+	query.WriteString(`SELECT `)
+
+	var tables, names []string
+	var types []reflect.Type
+
+	if r.columns != nil {
+		for _, column := range r.columns {
+			tables = append(tables, column.Table())
+			names = append(names, column.Column())
+			types = append(types, column.Type())
+		}
+	} else {
+		for i := 0; i < r.view.Columns(); i++ {
+			column := r.view.Column(i)
+			tables = append(tables, r.view.Table())
+			names = append(names, column.Column())
+			types = append(types, column.Type())
+		}
+	}
+
+	for i := range names {
+		if i > 0 {
+			query.WriteByte(',')
+		}
+		r.column(&query, tables[i], names[i])
+	}
+
+	query.WriteByte(' ')
+	query.WriteString(r.query)
+	r.limit(&query)
+
+	rows, err := r.my.QueryContext(r.ctx, query.String(), r.values...)
+	if err != nil {
+		return nil, Error{err, query.String()}
+	}
+	defer rows.Close()
+
+	pointers := make([]interface{}, len(types))
+	for i, rtype := range types {
+		pointers[i] = reflect.New(rtype).Interface()
+	}
+
+	var buffer bytes.Buffer
+	buffer.WriteString(`[`)
+
+	for index := 0; rows.Next(); index++ {
+		if index != 0 {
+			buffer.WriteByte(',')
+		}
+
+		if err := rows.Scan(pointers...); err != nil {
+			return nil, Error{err, query.String()}
+		}
+
+		buffer.WriteString(`{`)
+		for i, name := range names {
+			if i > 0 {
+				buffer.WriteByte(',')
+			}
+
+			buffer.WriteString(strconv.Quote(name))
+			buffer.WriteByte(':')
+
+			switch value := pointers[i].(type) {
+			case *uuid.UUID:
+				buffer.WriteString(strconv.Quote(value.String()))
+			default:
+				encoded, err := json.Marshal(value)
+				if err != nil {
+					return nil, Error{err, query.String()}
+				}
+				buffer.Write(encoded)
+			}
+		}
+		buffer.WriteString(`}`)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, Error{err, query.String()}
+	}
+
+	buffer.WriteByte(']')
+
+	return buffer.Bytes(), nil
+}
+
+//Update updates the results with the given updates.
+//Returns the number of results updated (or -1 if the statistic is unavailable).
+func (r results) Update(update db.Update, updates ...db.Update) (int, error) {
+	var values []interface{}
+
+	var query strings.Builder
+	query.WriteString(`UPDATE `)
+	query.WriteString(cname(r.table))
+	query.WriteString(` `)
+
+	query.WriteString("SET ")
+
+	var addupdate func(update db.Update)
+	addupdate = func(update db.Update) {
+		query.WriteString(cname(update.Column))
+		query.WriteString("=?")
+
+		values = append(values, update.Value)
+
+		if update.Then != nil {
+			query.WriteString(",")
+			addupdate(*update.Then)
+		}
+	}
+
+	addupdate(update)
+	for _, update := range updates {
+		query.WriteString(",")
+		addupdate(update)
+	}
+
+	query.WriteString(strings.TrimPrefix(r.query, "FROM "+cname(r.table)))
+
+	result, err := r.my.ExecContext(r.ctx, query.String(), append(values, r.values...)...)
+	if err != nil {
+		if isDuplicate(err) {
+			return 0, db.ErrDuplicateKey
+		}
+		if isForeignKey(err) {
+			return 0, db.ErrForeignKey
+		}
+		return 0, Error{err, query.String()}
+	}
+
+	number, err := result.RowsAffected()
+	if err != nil {
+		number = -1
+	}
+
+	return int(number), nil
+}
+
+//Delete deletes all the results from the database.
+func (r results) Delete() (int, error) {
+	var query strings.Builder
+	query.WriteString(`DELETE `)
+
+	query.WriteString(r.query)
+
+	result, err := r.my.ExecContext(r.ctx, query.String(), r.values...)
+	if err != nil {
+		if isForeignKey(err) {
+			return 0, db.ErrForeignKey
+		}
+		return 0, Error{err, query.String()}
+	}
+
+	number, err := result.RowsAffected()
+	if err != nil {
+		number = -1
+	}
+
+	return int(number), nil
+}
+
+//Get gets the matching columns of the results.
+func (r results) Get(variable db.Variable, variables ...db.Variable) (int, error) {
+	var query strings.Builder
+	query.WriteString(`SELECT `)
+
+	r.column(&query, variable.Table(), variable.Column())
+	for _, variable := range variables {
+		query.WriteByte(',')
+		r.column(&query, variable.Table(), variable.Column())
+	}
+
+	query.WriteByte(' ')
+	query.WriteString(r.query)
+	r.limit(&query)
+
+	var pointers = make([]interface{}, len(variables)+1)
+
+	if r.length == 1 {
+		row := r.my.QueryRowContext(r.ctx, query.String(), r.values...)
+
+		pointers[0] = variable.Pointer()
+		for i, variable := range variables {
+			pointers[i+1] = variable.Pointer()
+		}
+
+		if err := row.Scan(pointers...); err != nil {
+			if err == sql.ErrNoRows {
+				return 0, db.ErrNotFound
+			}
+			return 0, Error{err, query.String()}
+		}
+
+		return 1, nil
+	}
+
+	rows, err := r.my.QueryContext(r.ctx, query.String(), r.values...)
+	if err != nil {
+		return 0, Error{err, query.String()}
+	}
+	defer rows.Close()
+
+	var all = append([]db.Variable{variable}, variables...)
+
+	//The number of rows is unknown until they have all been read,
+	//so they are scanned into temporary values first.
+	var scanned = make([][]reflect.Value, len(all))
+
+	for rows.Next() {
+		for i, variable := range all {
+			value := reflect.New(variable.Type())
+			scanned[i] = append(scanned[i], value)
+			pointers[i] = value.Interface()
+		}
+
+		if err := rows.Scan(pointers...); err != nil {
+			return 0, Error{err, query.String()}
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return 0, Error{err, query.String()}
+	}
+
+	var count = len(scanned[0])
+	if count == 0 {
+		return 0, db.ErrNotFound
+	}
+
+	for i, variable := range all {
+		variable.Make(count)
+		for index, value := range scanned[i] {
+			reflect.ValueOf(variable.Slice(index)).Elem().Set(value.Elem())
+		}
+	}
+
+	return count, nil
+}
+
+//Count returns the number of results.
+func (r results) Count(value db.Viewable) (int, error) {
+	var query strings.Builder
+	query.WriteString(`SELECT `)
+	query.WriteString(`COUNT(*)`)
+	query.WriteByte(' ')
+
+	query.WriteString(r.query)
+
+	row := r.my.QueryRowContext(r.ctx, query.String(), r.values...)
+
+	var count int
+
+	if err := row.Scan(&count); err != nil {
+		return count, Error{err, query.String()}
+	}
+
+	return count, nil
+}
+
+//Sum returns the sum amount of the value in the given column of all results.
+func (r results) Sum(value db.Variable) error {
+	var query strings.Builder
+	query.WriteString(`SELECT `)
+	query.WriteString(`COALESCE(SUM(`)
+	r.column(&query, value.Table(), value.Column())
+	query.WriteString(`),0)`)
+	query.WriteByte(' ')
+
+	query.WriteString(r.query)
+
+	row := r.my.QueryRowContext(r.ctx, query.String(), r.values...)
+
+	if err := row.Scan(value.Pointer()); err != nil {
+		return Error{err, query.String()}
+	}
+
+	return nil
+}
+
+//Average returns the average value in the given column for all results.
+func (r results) Average(value db.Viewable) (float64, error) {
+	var query strings.Builder
+	query.WriteString(`SELECT `)
+	query.WriteString(`AVG(`)
+	r.column(&query, value.Table(), value.Column())
+	query.WriteByte(')')
+	query.WriteByte(' ')
+
+	query.WriteString(r.query)
+
+	row := r.my.QueryRowContext(r.ctx, query.String(), r.values...)
+
+	var avg *float64
+
+	if err := row.Scan(&avg); err != nil {
+		return 0, Error{err, query.String()}
+	}
+
+	if avg == nil {
+		return 0, db.ErrNotFound
+	}
+
+	return *avg, nil
+}
+
+//aggregate selects the result of the given aggregate expression on the given column of all results.
+//The expression is a format string for the column, ie. MIN(%v)
+func (r results) aggregate(expression string, value db.Viewable) (*sql.Row, string) {
+	var column strings.Builder
+	r.column(&column, value.Table(), value.Column())
+
+	var query strings.Builder
+	query.WriteString(`SELECT `)
+	fmt.Fprintf(&query, expression, column.String())
+	query.WriteByte(' ')
+
+	query.WriteString(r.query)
+
+	return r.my.QueryRowContext(r.ctx, query.String(), r.values...), query.String()
+}
+
+//extreme sets the variable to the result of MIN or MAX on its column.
+func (r results) extreme(function string, value db.Variable) error {
+	var result = reflect.New(reflect.TypeOf(value.Pointer()))
+
+	row, query := r.aggregate(function+"(%v)", value)
+	if err := row.Scan(result.Interface()); err != nil {
+		return Error{err, query}
+	}
+
+	//NULL, there are no results.
+	if result.Elem().IsNil() {
+		return db.ErrNotFound
+	}
+
+	reflect.ValueOf(value.Pointer()).Elem().Set(result.Elem().Elem())
+
+	return nil
+}
+
+//Min sets the variable to the smallest value in its column for all results.
+func (r results) Min(value db.Variable) error {
+	return r.extreme("MIN", value)
+}
+
+//Max sets the variable to the largest value in its column for all results.
+func (r results) Max(value db.Variable) error {
+	return r.extreme("MAX", value)
+}
+
+//CountDistinct returns the number of distinct values in the given column for all results.
+func (r results) CountDistinct(value db.Viewable) (int, error) {
+	var count int
+
+	row, query := r.aggregate("COUNT(DISTINCT %v)", value)
+	if err := row.Scan(&count); err != nil {
+		return 0, Error{err, query}
+	}
+	return count, nil
+}
@@ -0,0 +1,364 @@
+package mysql
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"qlova.store/db"
+)
+
+//kind returns the given type name the way that MySQL reports it, without the display width of integers,
+//so that the types written by definition can be compared against the COLUMN_TYPE of information_schema.
+func kind(tname string) string {
+	tname = strings.Replace(strings.ToLower(strings.TrimSpace(tname)), ", ", ",", -1)
+	switch tname {
+	case "boolean", "bool":
+		return "tinyint"
+	case "integer":
+		return "int"
+	case "decimal", "numeric":
+		return "decimal(10,0)"
+	}
+	if strings.HasPrefix(tname, "numeric(") {
+		tname = "decimal" + strings.TrimPrefix(tname, "numeric")
+	}
+	if strings.HasPrefix(tname, "decimal(") && !strings.Contains(tname, ",") {
+		return strings.TrimSuffix(tname, ")") + ",0)"
+	}
+	for _, number := range []string{"tinyint", "smallint", "int", "bigint"} {
+		if strings.HasPrefix(tname, number+"(") {
+			return number
+		}
+	}
+	return tname
+}
+
+//createIndex returns the statement that creates the given index on the table.
+func createIndex(table db.Table, index db.Index) string {
+	var columns = make([]string, len(index.Columns))
+	for i, column := range index.Columns {
+		columns[i] = cname(column)
+	}
+
+	var unique string
+	if index.Unique {
+		unique = "UNIQUE "
+	}
+
+	return fmt.Sprintf(`CREATE %vINDEX %v ON %v (%v)`,
+		unique, cname(index.Name), cname(table.Table()), strings.Join(columns, ","))
+}
+
+//enforcesForeignKeys returns true if the server checks foreign keys and creates tables with a storage engine that supports them.
+//Servers that do not report this, such as go-mysql-server, are assumed not to enforce foreign keys.
+func (d driver) enforcesForeignKeys() bool {
+	var checks int
+	var engine string
+	if err := d.QueryRow(`SELECT @@foreign_key_checks, @@default_storage_engine`).Scan(&checks, &engine); err != nil {
+		return false
+	}
+	return checks == 1 && strings.EqualFold(engine, "InnoDB")
+}
+
+//constraint returns the name of the constraint for the given foreign key of the table.
+func constraint(table db.Table, key db.ForeignKey) string {
+	return strings.ToLower(table.Table() + "_" + key.Column + "_fkey")
+}
+
+//addForeignKey returns the statement that adds the given foreign key to the table.
+func addForeignKey(table db.Table, key db.ForeignKey) string {
+	var action = "RESTRICT"
+	if key.OnDelete == db.Cascade {
+		action = "CASCADE"
+	}
+
+	return fmt.Sprintf(`ALTER TABLE %v ADD CONSTRAINT %v FOREIGN KEY (%v) REFERENCES %v (%v) ON DELETE %v`,
+		cname(table.Table()), cname(constraint(table, key)), cname(key.Column), cname(key.Table), cname(key.References), action)
+}
+
+//plan returns the differences between the table and the database, along with the statements that resolve them.
+func (d driver) plan(table db.Table) (db.Diff, error) {
+	var diff db.Diff
+
+	//Foreign keys are refused by servers that would accept them without enforcing them.
+	if len(db.ForeignKeys(table)) > 0 && !d.enforcesForeignKeys() {
+		return diff, db.ErrUnsupported
+	}
+
+	const columnsQuery = `SELECT COLUMN_NAME, COLUMN_TYPE, COLUMN_KEY FROM information_schema.COLUMNS
+		WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ?`
+
+	rows, err := d.Query(columnsQuery, table.Table())
+	if err != nil {
+		return diff, Error{err, columnsQuery}
+	}
+
+	ExistingColumns := make(map[string]string)
+	var ExistingKeys []string
+	for rows.Next() {
+		var column, ctype, key string
+		if err := rows.Scan(&column, &ctype, &key); err != nil {
+			rows.Close()
+			return diff, Error{err, columnsQuery}
+		}
+		column = strings.ToLower(column)
+		ExistingColumns[column] = ctype
+		if key == "PRI" {
+			ExistingKeys = append(ExistingKeys, column)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		rows.Close()
+		return diff, Error{err, columnsQuery}
+	}
+	rows.Close()
+
+	if len(ExistingColumns) == 0 {
+		var query strings.Builder
+
+		var keys []string
+
+		fmt.Fprintf(&query, `CREATE TABLE IF NOT EXISTS %v (`, cname(table.Table()))
+		for i := 0; i < table.Columns(); i++ {
+			column := table.Column(i)
+
+			definition, err := definition(column)
+			if err != nil {
+				return diff, err
+			}
+
+			if column.Key() {
+				keys = append(keys, cname(column.Column()))
+			}
+
+			query.WriteString(definition)
+
+			if i < table.Columns()-1 {
+				query.WriteByte(',')
+			}
+		}
+		if len(keys) > 0 {
+			fmt.Fprintf(&query, `,PRIMARY KEY (%v)`, strings.Join(keys, ","))
+		}
+		query.WriteByte(')')
+
+		diff.Changes = append(diff.Changes, db.Change{Difference: db.MissingTable, Table: table.Table()})
+		diff.Statements = append(diff.Statements, query.String())
+
+		for _, index := range db.Indexes(table) {
+			diff.Statements = append(diff.Statements, createIndex(table, index))
+		}
+		for _, key := range db.ForeignKeys(table) {
+			diff.Statements = append(diff.Statements, addForeignKey(table, key))
+		}
+
+		return diff, nil
+	}
+
+	//Ensure columns are in sync.
+	var keys []string
+	var columns = make(map[string]struct{}, table.Columns())
+	for i := 0; i < table.Columns(); i++ {
+		target := table.Column(i)
+
+		var column = strings.ToLower(target.Column())
+		columns[column] = struct{}{}
+
+		if target.Key() {
+			keys = append(keys, column)
+		}
+
+		tname, _, err := columnType(target)
+		if err != nil {
+			return diff, err
+		}
+
+		ctype, ok := ExistingColumns[column]
+		if ok {
+			if kind(ctype) != kind(tname) {
+				diff.Changes = append(diff.Changes, db.Change{
+					Difference: db.TypeMismatch,
+					Table:      table.Table(),
+					Column:     target.Column(),
+					Want:       tname,
+					Have:       ctype,
+				})
+			}
+			continue
+		}
+
+		definition, err := definition(target)
+		if err != nil {
+			return diff, err
+		}
+
+		diff.Changes = append(diff.Changes, db.Change{
+			Difference: db.MissingColumn,
+			Table:      table.Table(),
+			Column:     target.Column(),
+			Want:       tname,
+		})
+		diff.Statements = append(diff.Statements, fmt.Sprintf(`ALTER TABLE %v ADD %v`, cname(table.Table()), definition))
+	}
+
+	sort.Strings(keys)
+	sort.Strings(ExistingKeys)
+	if strings.Join(keys, ",") != strings.Join(ExistingKeys, ",") {
+		diff.Changes = append(diff.Changes, db.Change{
+			Difference: db.KeyMismatch,
+			Table:      table.Table(),
+			Want:       strings.Join(keys, ","),
+			Have:       strings.Join(ExistingKeys, ","),
+		})
+	}
+
+	const indexesQuery = `SELECT DISTINCT INDEX_NAME FROM information_schema.STATISTICS
+		WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ?`
+
+	rows, err = d.Query(indexesQuery, table.Table())
+	if err != nil {
+		return diff, Error{err, indexesQuery}
+	}
+
+	ExistingIndexes := make(map[string]struct{})
+	for rows.Next() {
+		var index string
+		if err := rows.Scan(&index); err != nil {
+			rows.Close()
+			return diff, Error{err, indexesQuery}
+		}
+		ExistingIndexes[strings.ToLower(index)] = struct{}{}
+	}
+	if err := rows.Err(); err != nil {
+		rows.Close()
+		return diff, Error{err, indexesQuery}
+	}
+	rows.Close()
+
+	for _, index := range db.Indexes(table) {
+		if _, ok := ExistingIndexes[strings.ToLower(index.Name)]; ok {
+			continue
+		}
+
+		diff.Changes = append(diff.Changes, db.Change{
+			Difference: db.MissingIndex,
+			Table:      table.Table(),
+			Column:     strings.Join(index.Columns, ","),
+			Index:      index.Name,
+		})
+		diff.Statements = append(diff.Statements, createIndex(table, index))
+	}
+
+	const foreignKeysQuery = `SELECT CONSTRAINT_NAME FROM information_schema.TABLE_CONSTRAINTS
+		WHERE CONSTRAINT_TYPE = 'FOREIGN KEY' AND TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ?`
+
+	rows, err = d.Query(foreignKeysQuery, table.Table())
+	if err != nil {
+		return diff, Error{err, foreignKeysQuery}
+	}
+
+	ExistingForeignKeys := make(map[string]struct{})
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			rows.Close()
+			return diff, Error{err, foreignKeysQuery}
+		}
+		ExistingForeignKeys[strings.ToLower(key)] = struct{}{}
+	}
+	if err := rows.Err(); err != nil {
+		rows.Close()
+		return diff, Error{err, foreignKeysQuery}
+	}
+	rows.Close()
+
+	for _, key := range db.ForeignKeys(table) {
+		if _, ok := ExistingForeignKeys[constraint(table, key)]; ok {
+			continue
+		}
+
+		diff.Changes = append(diff.Changes, db.Change{
+			Difference: db.MissingForeignKey,
+			Table:      table.Table(),
+			Column:     key.Column,
+			Want:       key.Table + "." + key.References,
+		})
+		diff.Statements = append(diff.Statements, addForeignKey(table, key))
+	}
+
+	var extra []string
+	for column := range ExistingColumns {
+		if _, ok := columns[column]; !ok {
+			extra = append(extra, column)
+		}
+	}
+	sort.Strings(extra)
+	for _, column := range extra {
+		diff.Changes = append(diff.Changes, db.Change{
+			Difference: db.ExtraColumn,
+			Table:      table.Table(),
+			Column:     column,
+			Have:       ExistingColumns[column],
+		})
+	}
+
+	return diff, nil
+}
+
+//Plan returns the changes that Sync would make to the database, without making them.
+func (d driver) Plan(table db.Table, tables ...db.Table) (db.Diff, error) {
+	if d.error != nil {
+		return db.Diff{}, d.error
+	}
+
+	diff, err := d.plan(table)
+	if err != nil {
+		return db.Diff{}, err
+	}
+	for _, table := range tables {
+		next, err := d.plan(table)
+		if err != nil {
+			return db.Diff{}, err
+		}
+		diff.Changes = append(diff.Changes, next.Changes...)
+		diff.Statements = append(diff.Statements, next.Statements...)
+	}
+	return diff, nil
+}
+
+//Sync syncs the Tables with the Database, adding any missing columns.
+//If constraints or types do not match up, an error is returned.
+func (d driver) Sync(table db.Table, tables ...db.Table) error {
+	if d.error != nil {
+		return d.error
+	}
+
+	sync := func(table db.Table) error {
+		diff, err := d.plan(table)
+		if err != nil {
+			return err
+		}
+		if err := diff.Err(); err != nil {
+			return err
+		}
+
+		for _, statement := range diff.Statements {
+			if _, err := d.Exec(statement); err != nil {
+				return Error{err, statement}
+			}
+		}
+
+		return nil
+	}
+
+	if err := sync(table); err != nil {
+		return err
+	}
+	for _, table := range tables {
+		if err := sync(table); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+
+	"qlova.store/db"
+)
+
+//transaction is a db.Tx backed by a mysql transaction.
+type transaction struct {
+	driver
+
+	tx *sql.Tx
+}
+
+//Connect connects the given viewer to view this transaction.
+//It then returns the transaction.
+func (t transaction) Connect(first db.Viewer, more ...db.Viewer) db.Driver {
+
+	connect := func(v db.Viewer) {
+		db.Connect(v, t)
+	}
+
+	connect(first)
+	for _, viewer := range more {
+		connect(viewer)
+	}
+	return t
+}
+
+//Begin returns db.ErrNestedTransaction.
+func (t transaction) Begin() (db.Tx, error) {
+	return nil, db.ErrNestedTransaction
+}
+
+//BeginContext returns db.ErrNestedTransaction.
+func (t transaction) BeginContext(context.Context) (db.Tx, error) {
+	return nil, db.ErrNestedTransaction
+}
+
+//Commit commits the transaction.
+func (t transaction) Commit() error {
+	if err := t.tx.Commit(); err != nil {
+		if err == sql.ErrTxDone {
+			return db.ErrTransactionDone
+		}
+		return err
+	}
+	return nil
+}
+
+//Rollback aborts the transaction.
+func (t transaction) Rollback() error {
+	if err := t.tx.Rollback(); err != nil {
+		if err == sql.ErrTxDone {
+			return db.ErrTransactionDone
+		}
+		return err
+	}
+	return nil
+}
+
+//Close rolls back the transaction if it hasn't been committed.
+func (t transaction) Close() error {
+	if err := t.tx.Rollback(); err != nil && err != sql.ErrTxDone {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package postgres
+
+import (
+	"errors"
+
+	"qlova.store/db"
+)
+
+//RenameColumn renames a column of the named table.
+func (d driver) RenameColumn(table, from, to string) error {
+	return d.alter(`ALTER TABLE ` + table + ` RENAME COLUMN ` + cname(from) + ` TO ` + cname(to) + `;`)
+}
+
+//DropColumn removes a column, along with its values, from the named table.
+func (d driver) DropColumn(table, column string) error {
+	return d.alter(`ALTER TABLE ` + table + ` DROP COLUMN ` + cname(column) + `;`)
+}
+
+//AlterColumn changes the column of the named table with the same name as the given column to the type of the given column.
+//Existing values are cast to the new type.
+func (d driver) AlterColumn(table string, column db.Column) error {
+	if auto, ok := column.(interface{ Auto() bool }); ok && auto.Auto() {
+		return errors.New("postgres: cannot change the type of auto column " + column.Column())
+	}
+
+	tname, dvalue, err := columnType(column)
+	if err != nil {
+		return err
+	}
+
+	var name = cname(column.Column())
+
+	//Columns that become nullable accept NULL, the others must not have any NULL values left.
+	var null = ` SET NOT NULL`
+	if dvalue == `NULL` {
+		null = ` DROP NOT NULL`
+	}
+
+	//The default of the previous type may not cast to the new type, so it is dropped first.
+	return d.alter(`ALTER TABLE ` + table +
+		` ALTER COLUMN ` + name + ` DROP DEFAULT,` +
+		` ALTER COLUMN ` + name + ` TYPE ` + tname + ` USING ` + name + `::` + tname + `,` +
+		` ALTER COLUMN ` + name + ` SET DEFAULT ` + dvalue + `,` +
+		` ALTER COLUMN ` + name + null + `;`)
+}
+
+//alter executes the given statement that alters a table.
+func (d driver) alter(query string) error {
+	if d.error != nil {
+		return d.error
+	}
+	if _, err := d.Exec(query); err != nil {
+		return Error{err, query}
+	}
+	return nil
+}
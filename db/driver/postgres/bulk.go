@@ -0,0 +1,271 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"qlova.store/db"
+)
+
+//copyThreshold is the number of rows, from which rows are inserted with COPY instead of INSERT.
+const copyThreshold = 1000
+
+//maxParameters is the maximum number of parameters that postgres accepts in a single statement.
+const maxParameters = 65535
+
+//atomic calls f with a transaction, either the one the driver is already in, or a new one that is committed if f succeeds.
+func (d driver) atomic(ctx context.Context, f func(tx *sql.Tx) error) error {
+	if tx, ok := d.executor.(*sql.Tx); ok {
+		return f(tx)
+	}
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := f(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+//bulk inserts the given rows, which all belong to the same table, with as few statements as possible.
+func bulk(ctx context.Context, tx *sql.Tx, rows []db.Row) error {
+	var insertions = make([]db.Insertion, len(rows))
+	for i, row := range rows {
+		if err := insertions[i].Row(row); err != nil {
+			return err
+		}
+	}
+
+	var table = rows[0].Row().Table()
+	var columns = insertions[0].Columns
+
+	//Values for auto columns are generated by postgres, unless they are given.
+	var autos = autoColumns(&insertions[0])
+	var generating bool
+	for _, insert := range insertions {
+		for _, auto := range insert.Autos {
+			generating = generating || auto
+		}
+	}
+
+	if len(rows) >= copyThreshold && !generating {
+		if err := copyIn(ctx, tx, table, columns, insertions); err != nil {
+			return err
+		}
+		return advance(ctx, tx, insertions)
+	}
+
+	var batch = maxParameters / len(columns)
+
+	for start, end := 0, 0; start < len(insertions); start = end {
+		end = start + batch
+		if end > len(insertions) {
+			end = len(insertions)
+		}
+
+		//Values given for auto columns advance the sequences before any more values are generated.
+		for i := start; generating && i < end; i++ {
+			if given(&insertions[i]) {
+				end = i + 1
+			}
+		}
+
+		var query strings.Builder
+		query.WriteString(`INSERT INTO `)
+		query.WriteString(table)
+		query.WriteString(` (`)
+
+		for i, column := range columns {
+			if i > 0 {
+				query.WriteByte(',')
+			}
+			query.WriteString(cname(column))
+		}
+
+		query.WriteString(`) VALUES `)
+
+		var values = make([]interface{}, 0, (end-start)*len(columns))
+
+		for i, insert := range insertions[start:end] {
+			if i > 0 {
+				query.WriteByte(',')
+			}
+			query.WriteByte('(')
+			for j, value := range insert.Values {
+				if j > 0 {
+					query.WriteByte(',')
+				}
+				if insert.Autos[j] {
+					query.WriteString(`DEFAULT`)
+					continue
+				}
+				values = append(values, value)
+				query.WriteByte('$')
+				query.WriteString(strconv.Itoa(len(values)))
+			}
+			query.WriteByte(')')
+		}
+
+		if !generating {
+			query.WriteString(`;`)
+
+			if _, err := tx.ExecContext(ctx, query.String(), values...); err != nil {
+				return insertError(err, query.String())
+			}
+			if err := advance(ctx, tx, insertions[start:end]); err != nil {
+				return err
+			}
+			continue
+		}
+
+		//The generated values are returned in the order of the rows.
+		query.WriteString(` RETURNING `)
+		for i, j := range autos {
+			if i > 0 {
+				query.WriteByte(',')
+			}
+			query.WriteString(cname(columns[j]))
+		}
+		query.WriteString(`;`)
+
+		results, err := tx.QueryContext(ctx, query.String(), values...)
+		if err != nil {
+			return insertError(err, query.String())
+		}
+
+		for i := start; results.Next(); i++ {
+			var generated = make([]interface{}, len(autos))
+			for k, j := range autos {
+				generated[k] = reflect.New(insertions[i].Table.Column(j).Type()).Interface()
+			}
+			if err := results.Scan(generated...); err != nil {
+				results.Close()
+				return Error{err, query.String()}
+			}
+			for k, j := range autos {
+				if insertions[i].Autos[j] {
+					insertions[i].Generated(j, reflect.ValueOf(generated[k]).Elem().Interface())
+				}
+			}
+		}
+
+		if err := results.Close(); err != nil {
+			return Error{err, query.String()}
+		}
+		if err := results.Err(); err != nil {
+			return insertError(err, query.String())
+		}
+		if err := advance(ctx, tx, insertions[start:end]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//given returns true if the insertion gives a value for any of its auto columns.
+func given(insert *db.Insertion) bool {
+	for _, i := range autoColumns(insert) {
+		if !insert.Autos[i] {
+			return true
+		}
+	}
+	return false
+}
+
+//insertError returns the db error that the given error of an insert query corresponds to.
+func insertError(err error, query string) error {
+	if isDuplicate(err) {
+		return db.ErrDuplicateKey
+	}
+	if isForeignKey(err) {
+		return db.ErrForeignKey
+	}
+	return Error{err, query}
+}
+
+//autoColumns returns the indices of the auto columns of the insertion.
+func autoColumns(insert *db.Insertion) []int {
+	var autos []int
+	for i := range insert.Columns {
+		if auto, ok := insert.Table.Column(i).(interface{ Auto() bool }); ok && auto.Auto() {
+			autos = append(autos, i)
+		}
+	}
+	return autos
+}
+
+//advance moves the sequences of the auto columns past the largest values that the insertions give for them,
+//so that the values that postgres generates afterwards do not collide with them.
+func advance(ctx context.Context, e executor, insertions []db.Insertion) error {
+	const query = `SELECT setval(s::regclass, GREATEST($3, COALESCE(pg_sequence_last_value(s::regclass), 0))) ` +
+		`FROM pg_get_serial_sequence($1, $2) AS s;`
+
+	var largest = make(map[int]int64)
+	for _, insert := range insertions {
+		for _, i := range autoColumns(&insert) {
+			if value := reflect.ValueOf(insert.Values[i]).Int(); !insert.Autos[i] && value > largest[i] {
+				largest[i] = value
+			}
+		}
+	}
+
+	for i, value := range largest {
+		var insert = insertions[0]
+		if _, err := e.ExecContext(ctx, query, insert.Table.Table(), strings.ToLower(insert.Columns[i]), value); err != nil {
+			return Error{err, query}
+		}
+	}
+	return nil
+}
+
+//copyIn inserts the given insertions into the table with COPY FROM STDIN.
+//The names are written like they are in every other query, pq.CopyIn would quote them and make them case-sensitive.
+func copyIn(ctx context.Context, tx *sql.Tx, table string, columns []string, insertions []db.Insertion) error {
+	var statement strings.Builder
+	statement.WriteString(`COPY `)
+	statement.WriteString(table)
+	statement.WriteString(` (`)
+	for i, column := range columns {
+		if i > 0 {
+			statement.WriteByte(',')
+		}
+		statement.WriteString(cname(column))
+	}
+	statement.WriteString(`) FROM STDIN`)
+
+	var query = statement.String()
+
+	stmt, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return Error{err, query}
+	}
+	defer stmt.Close()
+
+	for _, insert := range insertions {
+		if _, err := stmt.ExecContext(ctx, insert.Values...); err != nil {
+			return Error{err, query}
+		}
+	}
+
+	//Flush the buffered rows.
+	if _, err := stmt.ExecContext(ctx); err != nil {
+		if isDuplicate(err) {
+			return db.ErrDuplicateKey
+		}
+		if isForeignKey(err) {
+			return db.ErrForeignKey
+		}
+		return Error{err, query}
+	}
+
+	return nil
+}
@@ -1,17 +1,33 @@
 package postgres
 
 import (
+	"context"
 	"database/sql"
-	"fmt"
+	"reflect"
 	"strconv"
 	"strings"
 
+	"github.com/lib/pq"
 	"qlova.store/db"
+	"qlova.store/db/driver/internal/where"
 )
 
+//executor is implemented by *sql.DB and *sql.Tx.
+type executor interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
 //Driver is a db driver for Postgres databases.
 type driver struct {
-	*sql.DB
+	executor
+
+	db *sql.DB
 	error
 }
 
@@ -32,58 +48,187 @@ func (d driver) Connect(first db.Viewer, more ...db.Viewer) db.Driver {
 
 //Insert inserts the given row into the database.
 func (d driver) Insert(row db.Row, rows ...db.Row) error {
+	return d.InsertContext(context.Background(), row, rows...)
+}
 
-	insert := func(row db.Row) error {
+//put inserts the given row into the database.
+//If upsert is true, the given columns of an existing row with the same key are overwritten instead.
+func (d driver) put(ctx context.Context, row db.Row, upsert bool, columns []string) error {
+	var insert db.Insertion
+	if err := insert.Row(row); err != nil {
+		return err
+	}
 
-		var insert db.Insertion
-		if err := insert.Row(row); err != nil {
-			return err
+	var query strings.Builder
+	query.WriteString(`INSERT INTO `)
+	query.WriteString(row.Row().Table())
+	query.WriteString(` (`)
+
+	//Auto columns are left out, so that postgres generates them.
+	var values []interface{}
+	var generated []int
+
+	for i, column := range insert.Columns {
+		if insert.Autos[i] {
+			generated = append(generated, i)
+			continue
+		}
+		if len(values) > 0 {
+			query.WriteByte(',')
 		}
+		query.WriteString(cname(column))
+		values = append(values, insert.Values[i])
+	}
 
-		var query strings.Builder
-		query.WriteString(`INSERT INTO `)
-		query.WriteString(row.Row().Table())
-		query.WriteString(` (`)
+	query.WriteString(`) VALUES (`)
 
+	for i := range values {
+		if i > 0 {
+			query.WriteByte(',')
+		}
+		query.WriteByte('$')
+		query.WriteString(strconv.Itoa(i + 1))
+	}
+
+	query.WriteString(`)`)
+
+	if upsert {
+		var keys []string
 		for i, column := range insert.Columns {
-			query.WriteString(cname(column))
+			if insert.Uniques[i] {
+				keys = append(keys, cname(column))
+			}
+		}
 
-			if i < len(insert.Columns)-1 {
-				query.WriteByte(',')
+		if len(keys) > 0 {
+			query.WriteString(` ON CONFLICT (`)
+			query.WriteString(strings.Join(keys, ","))
+			query.WriteString(`) DO `)
+
+			var overwrites = insert.Overwrites(columns)
+			if len(overwrites) == 0 {
+				query.WriteString(`NOTHING`)
+			} else {
+				query.WriteString(`UPDATE SET `)
+				for i, j := range overwrites {
+					if i > 0 {
+						query.WriteByte(',')
+					}
+					query.WriteString(cname(insert.Columns[j]))
+					query.WriteString(`=EXCLUDED.`)
+					query.WriteString(cname(insert.Columns[j]))
+				}
 			}
 		}
+	}
 
-		query.WriteString(`) VALUES (`)
+	if len(generated) > 0 {
+		query.WriteString(` RETURNING `)
+		for i, j := range generated {
+			if i > 0 {
+				query.WriteByte(',')
+			}
+			query.WriteString(cname(insert.Columns[j]))
+		}
+		query.WriteString(`;`)
 
-		for i := range insert.Values {
-			query.WriteByte('$')
-			query.WriteString(strconv.Itoa(i + 1))
+		var results = make([]interface{}, len(generated))
+		for i, j := range generated {
+			results[i] = reflect.New(insert.Table.Column(j).Type()).Interface()
+		}
 
-			if i < len(insert.Columns)-1 {
-				query.WriteByte(',')
+		if err := d.QueryRowContext(ctx, query.String(), values...).Scan(results...); err != nil {
+			//The row already exists and was left as it is.
+			if err == sql.ErrNoRows {
+				return nil
 			}
+			return insertError(err, query.String())
 		}
 
-		query.WriteString(`);`)
+		for i, j := range generated {
+			insert.Generated(j, reflect.ValueOf(results[i]).Elem().Interface())
+		}
+
+		return nil
+	}
+
+	query.WriteString(`;`)
+
+	if _, err := d.ExecContext(ctx, query.String(), values...); err != nil {
+		return insertError(err, query.String())
+	}
+
+	//Values given for auto columns must not be generated again.
+	return advance(ctx, d, []db.Insertion{insert})
+}
+
+//isDuplicate reports whether the given error is a unique violation.
+func isDuplicate(err error) bool {
+	e, ok := err.(*pq.Error)
+	return ok && e.Code == "23505"
+}
+
+//isForeignKey reports whether the given error is a foreign key violation.
+func isForeignKey(err error) bool {
+	e, ok := err.(*pq.Error)
+	return ok && e.Code == "23503"
+}
+
+//InsertContext inserts the given row into the database with the given context.
+//Multiple rows are inserted atomically, in bulk.
+func (d driver) InsertContext(ctx context.Context, row db.Row, rows ...db.Row) error {
+	if d.error != nil {
+		return d.error
+	}
+
+	if len(rows) == 0 {
+		return d.put(ctx, row, false, nil)
+	}
+
+	var all = append([]db.Row{row}, rows...)
 
-		_, err := d.Exec(query.String(), insert.Values...)
+	return d.atomic(ctx, func(tx *sql.Tx) error {
+		//Consecutive rows of the same table are inserted together.
+		for start := 0; start < len(all); {
+			var end = start + 1
+			for end < len(all) && all[end].Row().Table() == all[start].Row().Table() {
+				end++
+			}
+
+			if err := bulk(ctx, tx, all[start:end]); err != nil {
+				return err
+			}
 
-		if err != nil {
-			return Error{err, query.String()}
+			start = end
 		}
+		return nil
+	})
+}
 
-		return err
+//UpsertContext inserts the given row into the database with the given context.
+//If a row with the same key already exists, the given columns of that row are overwritten instead (all columns if none are given).
+//Multiple rows are upserted atomically.
+func (d driver) UpsertContext(ctx context.Context, columns []string, row db.Row, rows ...db.Row) error {
+	if d.error != nil {
+		return d.error
 	}
 
-	if err := insert(row); err != nil {
-		return err
+	if len(rows) == 0 {
+		return d.put(ctx, row, true, columns)
 	}
-	for _, row := range rows {
-		if err := insert(row); err != nil {
+
+	return d.atomic(ctx, func(tx *sql.Tx) error {
+		var d = driver{tx, d.db, nil}
+		if err := d.put(ctx, row, true, columns); err != nil {
 			return err
 		}
-	}
-	return nil
+		for _, row := range rows {
+			if err := d.put(ctx, row, true, columns); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 }
 
 //Delete deletes the given tables.
@@ -157,76 +302,10 @@ func (d driver) Search(filter db.Filter) db.Results {
 		}
 	}
 
-	var addCondition func(c db.Condition)
-
-	addCondition = func(c db.Condition) {
-		if len(c.Cases) > 0 {
-			query.WriteByte('(')
-			defer func() {
-				for _, sub := range c.Cases {
-					if c.Invert {
-						query.WriteString(" AND ")
-					} else {
-						query.WriteString(" OR ")
-					}
-					addCondition(sub)
-				}
-				query.WriteByte(')')
-			}()
-		}
-
-		if c.Operator == db.OpTrue {
-			query.WriteString("TRUE")
-			return
-		}
-
-		if c.Operator == db.OpFalse {
-			query.WriteString("False")
-			return
-		}
-
-		if joined {
-			query.WriteString(c.Table)
-			query.WriteByte('.')
-		}
-		query.WriteString(cname(c.Column))
-		switch c.Operator {
-		case db.OpEquals:
-			query.WriteByte('=')
-		case db.OpDivisibleBy:
-			query.WriteByte('%')
-		case db.OpNotEquals:
-			query.WriteByte('!')
-			query.WriteByte('=')
-		case db.OpLessThan:
-			query.WriteByte('<')
-		case db.OpContains:
-			query.WriteString(" LIKE ")
-			c.Value = fmt.Sprintf("%%%v%%", c.Value)
-		case db.OpHasPrefix:
-			query.WriteString(" LIKE ")
-			c.Value = fmt.Sprintf("%v%%", c.Value)
-		default:
-			panic("unsupported operator: " + strconv.Itoa(int(c.Operator)))
-		}
-
-		query.WriteByte('$')
-		query.WriteString(strconv.Itoa(len(values) + 1))
-		values = append(values, c.Value)
-
-		if c.Operator == db.OpDivisibleBy {
-			query.WriteString(`=0`)
-		}
-	}
-
-	if filter.Condition.Operator != db.OpTrue || len(filter.Conditions) > 0 {
-		query.WriteString(" WHERE ")
-		addCondition(filter.Condition)
-		for _, condition := range filter.Conditions {
-			query.WriteString(" AND ")
-			addCondition(condition)
-		}
-	}
+	var conditions = where.Writer{Dialect: dialect, Joined: joined}
+	conditions.Where(filter)
+	query.WriteString(conditions.String())
+	values = conditions.Values
 
 	addSort := func(sort db.Sorter) {
 		if sort.Column != "" {
@@ -241,7 +320,18 @@ func (d driver) Search(filter db.Filter) db.Results {
 		}
 	}
 
-	if filter.Sort.Column != "" {
+	if len(filter.Groups) > 0 {
+		//Groups are ordered by the grouped columns.
+		for _, clause := range []string{" GROUP BY ", " ORDER BY "} {
+			query.WriteString(clause)
+			for i, group := range filter.Groups {
+				if i > 0 {
+					query.WriteString(",")
+				}
+				addSort(db.Sorter{Table: group.Table(), Column: group.Column()})
+			}
+		}
+	} else if filter.Sort.Column != "" {
 		query.WriteString(" ORDER BY ")
 		addSort(filter.Sort)
 		for _, sort := range filter.Sorts {
@@ -252,6 +342,7 @@ func (d driver) Search(filter db.Filter) db.Results {
 
 	return results{
 		pq:     d,
+		ctx:    filter.Context(),
 		query:  query.String(),
 		values: values,
 
@@ -265,10 +356,32 @@ func (d driver) Search(filter db.Filter) db.Results {
 		offset: filter.Offset,
 
 		columns: filter.Columns,
+
+		groups: filter.Groups,
 	}
 }
 
+//Begin starts a transaction.
+func (d driver) Begin() (db.Tx, error) {
+	return d.BeginContext(context.Background())
+}
+
+//BeginContext starts a transaction with the given context.
+//The transaction is rolled back if the context is done before the transaction is committed.
+func (d driver) BeginContext(ctx context.Context) (db.Tx, error) {
+	if d.error != nil {
+		return nil, d.error
+	}
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return transaction{driver{tx, d.db, nil}, tx}, nil
+}
+
 //Close closes the connection to the database.
 func (d driver) Close() error {
-	return d.DB.Close()
+	return d.db.Close()
 }
@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"reflect"
+	"strings"
+
+	"qlova.store/db"
+)
+
+//functions are the SQL names of the aggregate functions.
+var functions = map[db.Function]string{
+	db.FnCount:   "COUNT",
+	db.FnSum:     "SUM",
+	db.FnAverage: "AVG",
+	db.FnMin:     "MIN",
+	db.FnMax:     "MAX",
+}
+
+//Aggregate reads the grouped columns and the aggregates of each group of the results into their variables.
+func (r results) Aggregate(aggregate db.Aggregate, aggregates ...db.Aggregate) (int, error) {
+	var query strings.Builder
+	query.WriteString(`SELECT `)
+
+	var all []db.Variable
+
+	addColumn := func(table, column string) {
+		if r.joined {
+			query.WriteString(table)
+			query.WriteByte('.')
+		}
+		query.WriteString(cname(column))
+	}
+
+	for _, group := range r.groups {
+		if len(all) > 0 {
+			query.WriteByte(',')
+		}
+		addColumn(group.Table(), group.Column())
+		all = append(all, group)
+	}
+
+	for _, a := range append([]db.Aggregate{aggregate}, aggregates...) {
+		if len(all) > 0 {
+			query.WriteByte(',')
+		}
+		query.WriteString(functions[a.Function])
+		query.WriteByte('(')
+		if a.Function == db.FnCount {
+			query.WriteByte('*')
+		} else {
+			addColumn(a.Column.Table(), a.Column.Column())
+		}
+		query.WriteByte(')')
+		all = append(all, a.Into)
+	}
+
+	query.WriteByte(' ')
+	query.WriteString(r.query)
+
+	rows, err := r.pq.QueryContext(r.ctx, query.String(), r.values...)
+	if err != nil {
+		return 0, Error{err, query.String()}
+	}
+	defer rows.Close()
+
+	//The number of groups is unknown until they have all been read,
+	//so they are scanned into temporary values first.
+	var scanned = make([][]reflect.Value, len(all))
+	var pointers = make([]interface{}, len(all))
+
+	for rows.Next() {
+		for i, variable := range all {
+			value := reflect.New(reflect.TypeOf(variable.Pointer()).Elem())
+			scanned[i] = append(scanned[i], value)
+			pointers[i] = value.Interface()
+		}
+
+		if err := rows.Scan(pointers...); err != nil {
+			return 0, Error{err, query.String()}
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return 0, Error{err, query.String()}
+	}
+
+	var count = len(scanned[0])
+	if count == 0 {
+		return 0, db.ErrNotFound
+	}
+
+	for i, variable := range all {
+		variable.Make(count)
+		for index, value := range scanned[i] {
+			reflect.ValueOf(variable.Slice(index)).Elem().Set(value.Elem())
+		}
+	}
+
+	return count, nil
+}
@@ -0,0 +1,36 @@
+package postgres
+
+import (
+	"context"
+)
+
+//Lock blocks until the named advisory lock is acquired, the returned function releases it.
+//The lock is held by a dedicated connection, so it is shared with every other connection to the database.
+func (d driver) Lock(ctx context.Context, name string) (func() error, error) {
+	if d.error != nil {
+		return nil, d.error
+	}
+
+	conn, err := d.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	const query = `SELECT pg_advisory_lock(hashtext($1))`
+	if _, err := conn.ExecContext(ctx, query, name); err != nil {
+		conn.Close()
+		return nil, Error{err, query}
+	}
+
+	return func() error {
+		const query = `SELECT pg_advisory_unlock(hashtext($1))`
+		_, err := conn.ExecContext(context.Background(), query, name)
+		if closeErr := conn.Close(); err == nil && closeErr != nil {
+			return closeErr
+		}
+		if err != nil {
+			return Error{err, query}
+		}
+		return nil
+	}, nil
+}
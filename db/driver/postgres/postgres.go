@@ -12,15 +12,37 @@ import (
 	"github.com/google/uuid"
 	"github.com/lib/pq"
 	"qlova.store/db"
+	"qlova.store/db/driver/internal/where"
 )
 
 var _ = pq.Driver{}
 
+func init() {
+	db.Register("postgres", open)
+	db.Register("postgresql", open)
+}
+
+//open opens a postgres database for db.Open, the arguments are joined together to form the connection string.
+//The connection is verified before returning.
+func open(args ...string) (db.Driver, error) {
+	d, err := sql.Open("postgres", strings.Join(args, " "))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Ping(); err != nil {
+		d.Close()
+		return nil, err
+	}
+
+	return driver{d, d, nil}, nil
+}
+
 //Open sets the given connection to be backed by a postgres connection with the given options.
 func Open(connection string) db.Driver {
 	d, err := sql.Open("postgres", connection)
 
-	return driver{d, err}
+	return driver{d, d, err}
 }
 
 //Error wraps an error and a query.
@@ -40,6 +62,12 @@ func (err Error) Unwrap() error {
 }
 
 func typeInfo(rtype reflect.Type) (tname string, tvalue string, err error) {
+	//Nullable columns default to NULL.
+	if rtype.Kind() == reflect.Ptr {
+		tname, _, err := typeInfo(rtype.Elem())
+		return tname, `NULL`, err
+	}
+
 	var zero = reflect.Zero(rtype).Interface()
 
 	switch zero.(type) {
@@ -65,12 +93,117 @@ func typeInfo(rtype reflect.Type) (tname string, tvalue string, err error) {
 		return "timestamp", `'0001-01-01 00:00:00'`, nil
 	case uuid.UUID:
 		return "uuid", `'00000000-0000-0000-0000-000000000000'`, nil
+	case db.Dec:
+		return "numeric", `0`, nil
+	case db.Document:
+		return "jsonb", `'null'`, nil
 
 	default:
 		return "", "", errors.New("unsupported postgres db data type: " + rtype.String())
 	}
 }
 
+//columnType returns the type name and default value of the given column.
+func columnType(column db.Column) (tname string, dvalue string, err error) {
+	tname, dvalue, err = typeInfo(column.Type())
+	if err != nil {
+		return "", "", err
+	}
+
+	//Decimal columns can have a fixed precision and scale.
+	if p, ok := column.(interface{ Precision() int }); ok && tname == "numeric" && p.Precision() > 0 {
+		var scale int
+		if s, ok := column.(interface{ Scale() int }); ok {
+			scale = s.Scale()
+		}
+		tname = fmt.Sprintf("numeric(%v,%v)", p.Precision(), scale)
+	}
+
+	return tname, dvalue, nil
+}
+
+//definition returns the SQL definition of the given column.
+func definition(column db.Column) (string, error) {
+	tname, dvalue, err := columnType(column)
+	if err != nil {
+		return "", err
+	}
+
+	//Auto columns are generated by a sequence.
+	if auto, ok := column.(interface{ Auto() bool }); ok && auto.Auto() {
+		switch tname {
+		case "integer":
+			tname = "serial"
+		case "bigint":
+			tname = "bigserial"
+		default:
+			return "", errors.New("unsupported postgres auto data type: " + column.Type().String())
+		}
+
+		if column.Key() {
+			tname += " PRIMARY KEY"
+		}
+
+		return cname(column.Column()) + " " + tname, nil
+	}
+
+	//Only nullable columns default to NULL, the others must never be NULL.
+	if dvalue != `NULL` {
+		tname += " NOT NULL"
+	}
+
+	if column.Key() {
+		tname += " PRIMARY KEY"
+	}
+
+	return fmt.Sprintf(`%v %v DEFAULT %v`, cname(column.Column()), tname, dvalue), nil
+}
+
+//dialect writes conditions in the dialect of postgres.
+var dialect = where.Dialect{
+	Table:  func(name string) string { return name },
+	Column: cname,
+
+	Placeholder: func(n int) string {
+		return "$" + strconv.Itoa(n)
+	},
+
+	JSON: jsonb,
+}
+
+//jsonb writes a condition on a JSON document with the jsonb operators.
+func jsonb(w *where.Writer, column string, c db.Condition) {
+	w.WriteByte('(')
+	w.WriteString(column)
+	if len(c.Path) > 0 {
+		w.WriteString(" #> ")
+		w.Value(pq.Array(c.Path))
+	}
+	w.WriteByte(')')
+
+	switch c.Operator {
+	case db.OpEquals:
+		w.WriteByte('=')
+	case db.OpNotEquals:
+		w.WriteString("!=")
+	case db.OpLessThan:
+		w.WriteByte('<')
+	case db.OpGreaterThan:
+		w.WriteByte('>')
+	case db.OpContainsJSON:
+		w.WriteString(" @> ")
+	case db.OpHasKey:
+		w.WriteString(" ? ")
+		w.Value(c.Value)
+		return
+	default:
+		panic("unsupported JSON operator: " + strconv.Itoa(int(c.Operator)))
+	}
+
+	w.Value(c.Value)
+	w.WriteString("::jsonb")
+}
+
 func cname(name string) string {
 	name = strings.ToLower(name)
 	switch name {
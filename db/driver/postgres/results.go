@@ -2,10 +2,11 @@ package postgres
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/json"
 	"fmt"
-	"math"
+	"reflect"
 	"strconv"
 	"strings"
 
@@ -15,6 +16,7 @@ import (
 
 type results struct {
 	pq     driver
+	ctx    context.Context
 	query  string
 	values []interface{}
 
@@ -27,6 +29,8 @@ type results struct {
 	offset, length int
 
 	columns []db.Variable
+
+	groups []db.Variable
 }
 
 //MarshalJSON implements json.Marshaler
@@ -76,10 +80,11 @@ func (r results) MarshalJSON() ([]byte, error) {
 	//fmt.Println("\n\n" + query.String())
 	//fmt.Println(r.values...)
 
-	rows, err := r.pq.Query(query.String(), r.values...)
+	rows, err := r.pq.QueryContext(r.ctx, query.String(), r.values...)
 	if err != nil {
 		return nil, Error{err, query.String()}
 	}
+	defer rows.Close()
 
 	results := make([]interface{}, ColumnCount)
 
@@ -118,6 +123,20 @@ func (r results) MarshalJSON() ([]byte, error) {
 					var id uuid.UUID
 					id.Scan(result)
 					buffer.WriteString(strconv.Quote(id.String()))
+				case *db.JSON:
+					var document db.Document
+					document.Scan(result)
+					buffer.WriteString(document.String())
+				case *db.Decimal, *db.NullDecimal:
+					if result == nil {
+						buffer.WriteString("null")
+						break
+					}
+					var decimal db.Dec
+					if err := decimal.Scan(result); err != nil {
+						return nil, Error{err, query.String()}
+					}
+					buffer.WriteString(strconv.Quote(decimal.String()))
 				default:
 					encoded, err := json.Marshal(result)
 					if err != nil {
@@ -136,6 +155,20 @@ func (r results) MarshalJSON() ([]byte, error) {
 					var id uuid.UUID
 					id.Scan(result)
 					buffer.WriteString(strconv.Quote(id.String()))
+				case *db.JSON:
+					var document db.Document
+					document.Scan(result)
+					buffer.WriteString(document.String())
+				case *db.Decimal, *db.NullDecimal:
+					if result == nil {
+						buffer.WriteString("null")
+						break
+					}
+					var decimal db.Dec
+					if err := decimal.Scan(result); err != nil {
+						return nil, Error{err, query.String()}
+					}
+					buffer.WriteString(strconv.Quote(decimal.String()))
 				default:
 					encoded, err := json.Marshal(result)
 					if err != nil {
@@ -194,8 +227,14 @@ func (r results) Update(update db.Update, updates ...db.Update) (int, error) {
 
 	query.WriteString(strings.TrimPrefix(r.query, "FROM "+r.table))
 
-	result, err := r.pq.Exec(query.String(), r.values...)
+	result, err := r.pq.ExecContext(r.ctx, query.String(), r.values...)
 	if err != nil {
+		if isDuplicate(err) {
+			return 0, db.ErrDuplicateKey
+		}
+		if isForeignKey(err) {
+			return 0, db.ErrForeignKey
+		}
 		return 0, Error{err, query.String()}
 	}
 
@@ -214,8 +253,11 @@ func (r results) Delete() (int, error) {
 
 	query.WriteString(r.query)
 
-	result, err := r.pq.Exec(query.String(), r.values...)
+	result, err := r.pq.ExecContext(r.ctx, query.String(), r.values...)
 	if err != nil {
+		if isForeignKey(err) {
+			return 0, db.ErrForeignKey
+		}
 		return 0, Error{err, query.String()}
 	}
 
@@ -256,7 +298,7 @@ func (r results) Get(variable db.Variable, variables ...db.Variable) (int, error
 	//fmt.Printf("\n\n"+query.String()+"\n", r.values...)
 
 	if r.length == 1 {
-		row := r.pq.QueryRow(query.String(), r.values...)
+		row := r.pq.QueryRowContext(r.ctx, query.String(), r.values...)
 
 		var pointers = make([]interface{}, len(variables)+1)
 
@@ -277,10 +319,11 @@ func (r results) Get(variable db.Variable, variables ...db.Variable) (int, error
 		return 1, nil
 	}
 
-	rows, err := r.pq.Query(query.String(), r.values...)
+	rows, err := r.pq.QueryContext(r.ctx, query.String(), r.values...)
 	if err != nil {
 		return 0, Error{err, query.String()}
 	}
+	defer rows.Close()
 
 	var pointers = make([]interface{}, len(variables)+1)
 
@@ -321,7 +364,7 @@ func (r results) Count(value db.Viewable) (int, error) {
 
 	query.WriteString(r.query)
 
-	row := r.pq.QueryRow(query.String(), r.values...)
+	row := r.pq.QueryRowContext(r.ctx, query.String(), r.values...)
 
 	var count int
 
@@ -349,7 +392,7 @@ func (r results) Sum(value db.Variable) error {
 
 	query.WriteString(r.query)
 
-	row := r.pq.QueryRow(query.String(), r.values...)
+	row := r.pq.QueryRowContext(r.ctx, query.String(), r.values...)
 
 	err := row.Scan(value.Pointer())
 
@@ -379,19 +422,75 @@ func (r results) Average(value db.Viewable) (float64, error) {
 
 	query.WriteString(r.query)
 
-	row := r.pq.QueryRow(query.String(), r.values...)
+	row := r.pq.QueryRowContext(r.ctx, query.String(), r.values...)
 
 	var avg *float64
 
-	err := row.Scan(&avg)
+	if err := row.Scan(&avg); err != nil {
+		return 0, Error{err, query.String()}
+	}
 
 	if avg == nil {
-		return math.NaN(), Error{err, query.String()}
+		return 0, db.ErrNotFound
 	}
 
-	if err != nil {
-		return 0, Error{err, query.String()}
+	return *avg, nil
+}
+
+//aggregate selects the result of the given aggregate expression on the given column of all results.
+//The expression is a format string for the column, ie. MIN(%v)
+func (r results) aggregate(expression string, value db.Viewable) (*sql.Row, string) {
+	var column = cname(value.Column())
+	if r.joined {
+		column = value.Table() + "." + column
 	}
 
-	return *avg, err
+	var query strings.Builder
+	query.WriteString(`SELECT `)
+	fmt.Fprintf(&query, expression, column)
+	query.WriteByte(' ')
+
+	query.WriteString(r.query)
+
+	return r.pq.QueryRowContext(r.ctx, query.String(), r.values...), query.String()
+}
+
+//extreme sets the variable to the result of MIN or MAX on its column.
+func (r results) extreme(function string, value db.Variable) error {
+	var result = reflect.New(reflect.TypeOf(value.Pointer()))
+
+	row, query := r.aggregate(function+"(%v)", value)
+	if err := row.Scan(result.Interface()); err != nil {
+		return Error{err, query}
+	}
+
+	//NULL, there are no results.
+	if result.Elem().IsNil() {
+		return db.ErrNotFound
+	}
+
+	reflect.ValueOf(value.Pointer()).Elem().Set(result.Elem().Elem())
+
+	return nil
+}
+
+//Min sets the variable to the smallest value in its column for all results.
+func (r results) Min(value db.Variable) error {
+	return r.extreme("MIN", value)
+}
+
+//Max sets the variable to the largest value in its column for all results.
+func (r results) Max(value db.Variable) error {
+	return r.extreme("MAX", value)
+}
+
+//CountDistinct returns the number of distinct values in the given column for all results.
+func (r results) CountDistinct(value db.Viewable) (int, error) {
+	var count int
+
+	row, query := r.aggregate("COUNT(DISTINCT %v)", value)
+	if err := row.Scan(&count); err != nil {
+		return 0, Error{err, query}
+	}
+	return count, nil
 }
@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"database/sql"
+	"strconv"
+	"strings"
+
+	"qlova.store/db"
+)
+
+//rows is a db.Rows backed by sql.Rows.
+type rows struct {
+	*sql.Rows
+
+	query   string
+	columns []string
+}
+
+//Rows returns a cursor that reads the results one row at a time.
+func (r results) Rows() (db.Rows, error) {
+	var query strings.Builder
+	query.WriteString(`SELECT `)
+
+	var columns []string
+
+	addColumn := func(table, column string) {
+		if len(columns) > 0 {
+			query.WriteByte(',')
+		}
+		if r.joined {
+			query.WriteString(table)
+			query.WriteByte('.')
+		}
+		query.WriteString(cname(column))
+
+		columns = append(columns, column)
+	}
+
+	if r.columns != nil {
+		for _, column := range r.columns {
+			addColumn(column.Table(), column.Column())
+		}
+	} else {
+		for i := 0; i < r.view.Columns(); i++ {
+			addColumn(r.view.Table(), r.view.Column(i).Column())
+		}
+	}
+
+	query.WriteByte(' ')
+	query.WriteString(r.query)
+
+	if r.length > 0 {
+		query.WriteString(` LIMIT `)
+		query.WriteString(strconv.Itoa(r.length))
+	}
+	if r.offset > 0 {
+		query.WriteString(` OFFSET `)
+		query.WriteString(strconv.Itoa(r.offset))
+	}
+
+	sqlrows, err := r.pq.QueryContext(r.ctx, query.String(), r.values...)
+	if err != nil {
+		return nil, Error{err, query.String()}
+	}
+
+	return rows{sqlrows, query.String(), columns}, nil
+}
+
+//Scan reads the current row into the given viewer.
+//Columns that the viewer does not have are discarded.
+func (r rows) Scan(v db.Viewer) error {
+	if v.Master() {
+		return db.ErrIllegalMaster
+	}
+
+	var pointers = make([]interface{}, len(r.columns))
+	for i, name := range r.columns {
+		pointers[i] = new(interface{})
+
+		for j := 0; j < v.Columns(); j++ {
+			if column := v.Column(j); column.Column() == name {
+				pointers[i] = db.Mutate(v, column).Pointer()
+				break
+			}
+		}
+	}
+
+	if err := r.Rows.Scan(pointers...); err != nil {
+		return Error{err, r.query}
+	}
+
+	return nil
+}
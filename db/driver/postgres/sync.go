@@ -1,37 +1,119 @@
 package postgres
 
 import (
-	"errors"
+	"database/sql"
 	"fmt"
+	"sort"
 	"strings"
 
 	"qlova.store/db"
 )
 
-//Sync syncs the Tables with the Database, adding any missing columns.
-//If constraints or types do not match up, an error is returned.
-func (d driver) Sync(table db.Table, tables ...db.Table) error {
-	if d.error != nil {
-		return d.error
+//kind returns the name that postgres reports for the given type name, so that the
+//types written by definition can be compared against the data_type of information_schema.
+func kind(tname string) string {
+	tname = strings.ToLower(strings.TrimSpace(tname))
+	switch tname {
+	case "serial", "serial4", "int", "int4":
+		return "integer"
+	case "bigserial", "serial8", "int8":
+		return "bigint"
+	case "float4":
+		return "real"
+	case "float8":
+		return "double precision"
+	case "bool":
+		return "boolean"
+	case "timestamp":
+		return "timestamp without time zone"
+	case "timestamptz":
+		return "timestamp with time zone"
+	case "decimal":
+		return "numeric"
+	default:
+		if strings.HasPrefix(tname, "decimal(") {
+			return "numeric" + strings.TrimPrefix(tname, "decimal")
+		}
+		return strings.Replace(tname, ", ", ",", -1)
+	}
+}
+
+//createIndex returns the statement that creates the given index on the table.
+func createIndex(table db.Table, index db.Index) string {
+	var columns = make([]string, len(index.Columns))
+	for i, column := range index.Columns {
+		columns[i] = cname(column)
 	}
 
-	sync := func(table db.Table) error {
+	var unique string
+	if index.Unique {
+		unique = "UNIQUE "
+	}
+
+	return fmt.Sprintf(`CREATE %vINDEX IF NOT EXISTS %v ON %v (%v)`,
+		unique, cname(index.Name), table.Table(), strings.Join(columns, ","))
+}
+
+//constraint returns the name of the constraint for the given foreign key of the table.
+func constraint(table db.Table, key db.ForeignKey) string {
+	return strings.ToLower(table.Table() + "_" + key.Column + "_fkey")
+}
+
+//addForeignKey returns the statement that adds the given foreign key to the table.
+func addForeignKey(table db.Table, key db.ForeignKey) string {
+	var action = "RESTRICT"
+	if key.OnDelete == db.Cascade {
+		action = "CASCADE"
+	}
+
+	return fmt.Sprintf(`ALTER TABLE %v ADD CONSTRAINT %v FOREIGN KEY (%v) REFERENCES %v (%v) ON DELETE %v`,
+		table.Table(), cname(constraint(table, key)), cname(key.Column), key.Table, cname(key.References), action)
+}
+
+//plan returns the differences between the table and the database, along with the statements that resolve them.
+func (d driver) plan(table db.Table) (db.Diff, error) {
+	var diff db.Diff
+	var name = strings.ToLower(table.Table())
+
+	const columnsQuery = `SELECT column_name, data_type, numeric_precision, numeric_scale FROM information_schema.columns
+		WHERE table_schema = current_schema() AND table_name = $1`
+
+	rows, err := d.Query(columnsQuery, name)
+	if err != nil {
+		return diff, Error{err, columnsQuery}
+	}
+
+	ExistingColumns := make(map[string]string)
+	for rows.Next() {
+		var column, dtype string
+		var precision, scale sql.NullInt64
+		if err := rows.Scan(&column, &dtype, &precision, &scale); err != nil {
+			rows.Close()
+			return diff, Error{err, columnsQuery}
+		}
+		//Only numeric columns with a fixed precision report it as part of their type.
+		if dtype == "numeric" && precision.Valid {
+			dtype = fmt.Sprintf("numeric(%v,%v)", precision.Int64, scale.Int64)
+		}
+		ExistingColumns[column] = kind(dtype)
+	}
+	if err := rows.Err(); err != nil {
+		rows.Close()
+		return diff, Error{err, columnsQuery}
+	}
+	rows.Close()
+
+	if len(ExistingColumns) == 0 {
 		var query strings.Builder
 
 		fmt.Fprintf(&query, `CREATE TABLE IF NOT EXISTS %v (`, table.Table())
 		for i := 0; i < table.Columns(); i++ {
-			column := table.Column(i)
-
-			tname, dvalue, err := typeInfo(column.Type())
+			def, err := definition(table.Column(i))
 			if err != nil {
-				return err
+				return diff, err
 			}
 
-			if column.Key() {
-				tname += " PRIMARY KEY"
-			}
-
-			fmt.Fprintf(&query, `%v %v DEFAULT %v`, cname(column.Column()), tname, dvalue)
+			query.WriteString(def)
 
 			if i < table.Columns()-1 {
 				query.WriteByte(',')
@@ -39,94 +121,233 @@ func (d driver) Sync(table db.Table, tables ...db.Table) error {
 		}
 		query.WriteByte(')')
 
-		_, err := d.Exec(query.String())
-		if err != nil {
-			return Error{err, query.String()}
+		diff.Changes = append(diff.Changes, db.Change{Difference: db.MissingTable, Table: table.Table()})
+		diff.Statements = append(diff.Statements, query.String())
+
+		for _, index := range db.Indexes(table) {
+			diff.Statements = append(diff.Statements, createIndex(table, index))
+		}
+		for _, key := range db.ForeignKeys(table) {
+			diff.Statements = append(diff.Statements, addForeignKey(table, key))
+		}
+
+		return diff, nil
+	}
+
+	const keysQuery = `SELECT kcu.column_name FROM information_schema.table_constraints tc
+		JOIN information_schema.key_column_usage kcu
+		ON tc.constraint_name = kcu.constraint_name AND tc.table_schema = kcu.table_schema
+		WHERE tc.constraint_type = 'PRIMARY KEY' AND tc.table_schema = current_schema() AND tc.table_name = $1`
+
+	rows, err = d.Query(keysQuery, name)
+	if err != nil {
+		return diff, Error{err, keysQuery}
+	}
+
+	var ExistingKeys []string
+	for rows.Next() {
+		var column string
+		if err := rows.Scan(&column); err != nil {
+			rows.Close()
+			return diff, Error{err, keysQuery}
 		}
+		ExistingKeys = append(ExistingKeys, column)
+	}
+	if err := rows.Err(); err != nil {
+		rows.Close()
+		return diff, Error{err, keysQuery}
+	}
+	rows.Close()
+
+	//Ensure columns are in sync.
+	var keys []string
+	var columns = make(map[string]struct{}, table.Columns())
+	for i := 0; i < table.Columns(); i++ {
+		target := table.Column(i)
 
-		query = strings.Builder{}
-		fmt.Fprintf(&query, `select * from %v LIMIT 1`, table.Table())
+		var column = strings.ToLower(target.Column())
+		columns[column] = struct{}{}
 
-		rows, err := d.Query(query.String())
+		if target.Key() {
+			keys = append(keys, column)
+		}
+
+		tname, _, err := columnType(target)
 		if err != nil {
-			return Error{err, query.String()}
+			return diff, err
+		}
+
+		dtype, ok := ExistingColumns[column]
+		if ok {
+			if dtype != kind(tname) {
+				diff.Changes = append(diff.Changes, db.Change{
+					Difference: db.TypeMismatch,
+					Table:      table.Table(),
+					Column:     target.Column(),
+					Want:       kind(tname),
+					Have:       dtype,
+				})
+			}
+			continue
 		}
 
-		columns, err := rows.Columns()
+		def, err := definition(target)
 		if err != nil {
-			return Error{err, query.String()}
+			return diff, err
 		}
 
+		diff.Changes = append(diff.Changes, db.Change{
+			Difference: db.MissingColumn,
+			Table:      table.Table(),
+			Column:     target.Column(),
+			Want:       kind(tname),
+		})
+		diff.Statements = append(diff.Statements, fmt.Sprintf(`ALTER TABLE %v ADD %v`, table.Table(), def))
+	}
+
+	sort.Strings(keys)
+	sort.Strings(ExistingKeys)
+	if strings.Join(keys, ",") != strings.Join(ExistingKeys, ",") {
+		diff.Changes = append(diff.Changes, db.Change{
+			Difference: db.KeyMismatch,
+			Table:      table.Table(),
+			Want:       strings.Join(keys, ","),
+			Have:       strings.Join(ExistingKeys, ","),
+		})
+	}
+
+	const indexesQuery = `SELECT indexname FROM pg_indexes WHERE schemaname = current_schema() AND tablename = $1`
+
+	rows, err = d.Query(indexesQuery, name)
+	if err != nil {
+		return diff, Error{err, indexesQuery}
+	}
+
+	ExistingIndexes := make(map[string]struct{})
+	for rows.Next() {
+		var index string
+		if err := rows.Scan(&index); err != nil {
+			rows.Close()
+			return diff, Error{err, indexesQuery}
+		}
+		ExistingIndexes[index] = struct{}{}
+	}
+	if err := rows.Err(); err != nil {
 		rows.Close()
+		return diff, Error{err, indexesQuery}
+	}
+	rows.Close()
 
-		ExistingColumns := make(map[string]struct{}, len(columns))
-		for _, column := range columns {
-			ExistingColumns[strings.ToLower(column)] = struct{}{}
-		}
-
-		//Query existing constraints.
-		query = strings.Builder{}
-		fmt.Fprintf(&query, `select
-		INFORMATION_SCHEMA.constraint_column_usage.column_name,
-		INFORMATION_SCHEMA.TABLE_CONSTRAINTS.constraint_type,
-		INFORMATION_SCHEMA.CHECK_CONSTRAINTS.check_clause
-		from INFORMATION_SCHEMA.TABLE_CONSTRAINTS
-		full outer join INFORMATION_SCHEMA.CHECK_CONSTRAINTS on
-		INFORMATION_SCHEMA.TABLE_CONSTRAINTS.constraint_name=INFORMATION_SCHEMA.CHECK_CONSTRAINTS.constraint_name
-		full outer join INFORMATION_SCHEMA.key_column_usage on
-		INFORMATION_SCHEMA.TABLE_CONSTRAINTS.constraint_name=INFORMATION_SCHEMA.key_column_usage.constraint_name
-		full outer join INFORMATION_SCHEMA.constraint_column_usage on
-		INFORMATION_SCHEMA.TABLE_CONSTRAINTS.constraint_name=INFORMATION_SCHEMA.constraint_column_usage.constraint_name
-		where INFORMATION_SCHEMA.TABLE_CONSTRAINTS.table_name='%v';
-	`, table.Table())
-
-		rows, err = d.Query(query.String())
-		if err != nil {
-			return Error{err, query.String()}
+	for _, index := range db.Indexes(table) {
+		if _, ok := ExistingIndexes[strings.ToLower(index.Name)]; ok {
+			continue
 		}
 
-		ExistingConstraints := make(map[string]struct{})
-		for rows.Next() {
-			if err := rows.Err(); err != nil {
-				return Error{err, query.String()}
-			}
-			var name, ctype, check *string
-			err := rows.Scan(&name, &ctype, &check)
-			if err != nil {
-				return Error{err, query.String()}
-			}
+		diff.Changes = append(diff.Changes, db.Change{
+			Difference: db.MissingIndex,
+			Table:      table.Table(),
+			Column:     strings.Join(index.Columns, ","),
+			Index:      index.Name,
+		})
+		diff.Statements = append(diff.Statements, createIndex(table, index))
+	}
 
-			if name != nil {
-				ExistingConstraints[*name+" "+*ctype] = struct{}{}
-			} else if check != nil {
-				ExistingConstraints[*check] = struct{}{}
-			} else {
-				return errors.New("unknown constraint: " + *ctype)
-			}
+	const foreignKeysQuery = `SELECT constraint_name FROM information_schema.table_constraints
+		WHERE constraint_type = 'FOREIGN KEY' AND table_schema = current_schema() AND table_name = $1`
+
+	rows, err = d.Query(foreignKeysQuery, name)
+	if err != nil {
+		return diff, Error{err, foreignKeysQuery}
+	}
+
+	ExistingForeignKeys := make(map[string]struct{})
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			rows.Close()
+			return diff, Error{err, foreignKeysQuery}
 		}
+		ExistingForeignKeys[key] = struct{}{}
+	}
+	if err := rows.Err(); err != nil {
+		rows.Close()
+		return diff, Error{err, foreignKeysQuery}
+	}
+	rows.Close()
 
-		//Ensure columns are in sync.
-		for i := 0; i < table.Columns(); i++ {
-			target := table.Column(i)
+	for _, key := range db.ForeignKeys(table) {
+		if _, ok := ExistingForeignKeys[constraint(table, key)]; ok {
+			continue
+		}
 
-			if _, ok := ExistingColumns[strings.ToLower(target.Column())]; ok {
-				continue
-			}
+		diff.Changes = append(diff.Changes, db.Change{
+			Difference: db.MissingForeignKey,
+			Table:      table.Table(),
+			Column:     key.Column,
+			Want:       key.Table + "." + key.References,
+		})
+		diff.Statements = append(diff.Statements, addForeignKey(table, key))
+	}
 
-			tname, dvalue, _ := typeInfo(target.Type())
+	var extra []string
+	for column := range ExistingColumns {
+		if _, ok := columns[column]; !ok {
+			extra = append(extra, column)
+		}
+	}
+	sort.Strings(extra)
+	for _, column := range extra {
+		diff.Changes = append(diff.Changes, db.Change{
+			Difference: db.ExtraColumn,
+			Table:      table.Table(),
+			Column:     column,
+			Have:       ExistingColumns[column],
+		})
+	}
 
-			if target.Key() {
-				tname += " PRIMARY KEY"
-			}
+	return diff, nil
+}
 
-			query = strings.Builder{}
+//Plan returns the changes that Sync would make to the database, without making them.
+func (d driver) Plan(table db.Table, tables ...db.Table) (db.Diff, error) {
+	if d.error != nil {
+		return db.Diff{}, d.error
+	}
 
-			fmt.Fprintf(&query, `ALTER TABLE %v ADD %v %v DEFAULT %v`,
-				table.Table(), target.Column(), tname, dvalue)
+	diff, err := d.plan(table)
+	if err != nil {
+		return db.Diff{}, err
+	}
+	for _, table := range tables {
+		next, err := d.plan(table)
+		if err != nil {
+			return db.Diff{}, err
+		}
+		diff.Changes = append(diff.Changes, next.Changes...)
+		diff.Statements = append(diff.Statements, next.Statements...)
+	}
+	return diff, nil
+}
 
-			_, err = d.Exec(query.String())
-			if err != nil {
-				return Error{err, query.String()}
+//Sync syncs the Tables with the Database, adding any missing columns.
+//If constraints or types do not match up, an error is returned.
+func (d driver) Sync(table db.Table, tables ...db.Table) error {
+	if d.error != nil {
+		return d.error
+	}
+
+	sync := func(table db.Table) error {
+		diff, err := d.plan(table)
+		if err != nil {
+			return err
+		}
+		if err := diff.Err(); err != nil {
+			return err
+		}
+
+		for _, statement := range diff.Statements {
+			if _, err := d.Exec(statement); err != nil {
+				return Error{err, statement}
 			}
 		}
 
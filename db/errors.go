@@ -29,3 +29,33 @@ const ErrDuplicateKey Error = "duplicate key"
 
 //ErrNotFound is returned if no row is found when getting from the database.
 const ErrNotFound Error = "row not found"
+
+//ErrNestedTransaction is returned when trying to begin a transaction inside of a transaction.
+const ErrNestedTransaction Error = "nested transactions are not supported"
+
+//ErrTransactionDone is returned when a transaction is used after it has been committed or rolled back.
+const ErrTransactionDone Error = "transaction has already been committed or rolled back"
+
+//ErrTransactionConflict is returned when a transaction cannot be committed because the data it used was changed outside of it.
+//The transaction is rolled back and can be retried.
+const ErrTransactionConflict Error = "transaction conflicts with a concurrent write"
+
+//ErrInvalidCursor is returned when a cursor token is malformed or was created by a filter with different sorting,
+//or when the results of a filter cannot be continued from a row, because it has no sorters or sorts on a nullable column.
+const ErrInvalidCursor Error = "invalid cursor"
+
+//ErrIrreversibleMigration is returned when a migration without a Down function would have to be reverted.
+const ErrIrreversibleMigration Error = "irreversible migration"
+
+//ErrUnknownMigration is returned when the database has a migration applied that is not known to the migrator.
+const ErrUnknownMigration Error = "unknown migration"
+
+//ErrForeignKey is returned when a row references a row that does not exist,
+//or when a row cannot be deleted because other rows still reference it.
+const ErrForeignKey Error = "foreign key violation"
+
+//ErrDecimalOverflow is returned when a decimal has more digits before the decimal point than the precision and scale of its column allow.
+const ErrDecimalOverflow Error = "decimal does not fit the precision of the column"
+
+//ErrUnsupported is returned when the driver does not support the operation.
+const ErrUnsupported Error = "operation is not supported by the driver"
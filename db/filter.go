@@ -1,5 +1,7 @@
 package db
 
+import "context"
+
 //Linker links two tables together so that they can be searched on.
 type Linker struct {
 	From, To Viewable
@@ -27,12 +29,12 @@ func (s Slicer) Into(v Viewer) (int, error) {
 
 	s.Columns = columns
 
-	return v.Database().Search(Filter(s)).Get(columns[0], columns[1:]...)
+	return Filter(s).search(v.Database()).Get(columns[0], columns[1:]...)
 }
 
 //Read reads into the given variables.
 func (s Slicer) Read() (int, error) {
-	return Filter(s).driver.Search(Filter(s)).Get(s.Columns[0], s.Columns[1:]...)
+	return Filter(s).search(Filter(s).driver).Get(s.Columns[0], s.Columns[1:]...)
 }
 
 //MarshalJSON implements json.Marshaler
@@ -40,9 +42,15 @@ func (s Slicer) MarshalJSON() ([]byte, error) {
 	return Filter(s).MarshalJSON()
 }
 
+//Rows returns a cursor over the slice.
+func (s Slicer) Rows() (Rows, error) {
+	return Filter(s).search(Filter(s).driver).Rows()
+}
+
 //Filter describes which rows to select in a database.
 type Filter struct {
 	driver Driver
+	ctx    context.Context
 
 	Table string
 	View  Table
@@ -59,6 +67,20 @@ type Filter struct {
 	Offset, Length int
 
 	Columns []Variable
+
+	//Groups are the columns to group the results by.
+	Groups []Variable
+
+	//err is returned by every operation on the filter instead of searching the database.
+	err error
+}
+
+//search returns the results of the filter in the given database.
+func (f Filter) search(d Driver) Results {
+	if f.err != nil {
+		return failure{f.err}
+	}
+	return d.Search(f)
 }
 
 //Link returns a filter with the given links applied.
@@ -105,6 +127,24 @@ func (f Filter) If(condition Condition, conditions ...Condition) Filter {
 	return f
 }
 
+//WithContext returns a filter that performs its operations with the given context.
+//The context can be used to cancel the operation or set a deadline for it.
+func (f Filter) WithContext(ctx context.Context) Filter {
+	if ctx == nil {
+		panic("nil context")
+	}
+	f.ctx = ctx
+	return f
+}
+
+//Context returns the context of the filter, by default this is context.Background()
+func (f Filter) Context() context.Context {
+	if f.ctx == nil {
+		return context.Background()
+	}
+	return f.ctx
+}
+
 //Slice selects the slice of the results that this filter should return.
 func (f Filter) Slice(offset, length int, columns ...Variable) Slicer {
 	f.Offset = offset
@@ -133,7 +173,7 @@ func (f Filter) Get(v Viewer) error {
 		columns[i] = Mutate(v, v.Column(i))
 	}
 
-	_, err := v.Database().Search(f).Get(columns[0], columns[1:]...)
+	_, err := f.search(v.Database()).Get(columns[0], columns[1:]...)
 
 	return err
 }
@@ -141,17 +181,17 @@ func (f Filter) Get(v Viewer) error {
 //Update updates the selected items with the given updates.
 //Returns the number of items updated (or -1 if the statistic is unavailable).
 func (f Filter) Update(update Update, updates ...Update) (int, error) {
-	return update.Database().Search(f).Update(update, updates...)
+	return f.search(update.Database()).Update(update, updates...)
 }
 
 //Delete deletes all the results from the database.
 func (f Filter) Delete() (int, error) {
-	return f.driver.Search(f).Delete()
+	return f.search(f.driver).Delete()
 }
 
 //Read reads into the given variables.
 func (f Filter) Read(v Variable, vs ...Variable) error {
-	_, err := v.Database().Search(f).Get(v, vs...)
+	_, err := f.search(v.Database()).Get(v, vs...)
 	return err
 }
 
@@ -164,20 +204,56 @@ func (f Filter) SortBy(sorter Sorter, sorters ...Sorter) Filter {
 
 //MarshalJSON encodes the results of the filter into JSON.
 func (f Filter) MarshalJSON() ([]byte, error) {
-	return f.driver.Search(f).MarshalJSON()
+	return f.search(f.driver).MarshalJSON()
 }
 
 //Count counts the number of results.
 func (f Filter) Count(v Viewable) (int, error) {
-	return f.driver.Search(f).Count(v)
+	return f.search(f.driver).Count(v)
 }
 
 //Average sets the variable to the average value of all results of that column.
 func (f Filter) Average(v Viewable) (float64, error) {
-	return f.driver.Search(f).Average(v)
+	return f.search(f.driver).Average(v)
 }
 
 //Sum sets the variable to the sum of all results of that column.
 func (f Filter) Sum(v Variable) error {
-	return f.driver.Search(f).Sum(v)
+	return f.search(f.driver).Sum(v)
+}
+
+//Min sets the variable to the smallest value of all results of that column.
+func (f Filter) Min(v Variable) error {
+	return f.search(f.driver).Min(v)
+}
+
+//Max sets the variable to the largest value of all results of that column.
+func (f Filter) Max(v Variable) error {
+	return f.search(f.driver).Max(v)
+}
+
+//CountDistinct counts the number of distinct values of all results of that column.
+func (f Filter) CountDistinct(v Viewable) (int, error) {
+	return f.search(f.driver).CountDistinct(v)
+}
+
+//Rows returns a cursor over all of the results of the filter.
+//Rows are read from the database as the cursor advances, so that any number of results can be read in constant memory.
+func (f Filter) Rows() (Rows, error) {
+	f.Offset = 0
+	f.Length = 0
+	return f.search(f.driver).Rows()
+}
+
+//Range returns an iterator over all of the results of the filter, starting at the filter's offset.
+//The results are fetched from the database, in pages of the given size, as the iterator advances.
+func (f Filter) Range(v Viewer, size int) *Iterator {
+	if size < 1 {
+		panic("invalid page size")
+	}
+	f.Length = size
+	return &Iterator{
+		Viewer: v,
+		page:   &f,
+	}
 }
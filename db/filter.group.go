@@ -0,0 +1,66 @@
+package db
+
+//Function is an aggregate function.
+type Function int
+
+//Aggregate functions
+const (
+	FnCount Function = iota
+	FnSum
+	FnAverage
+	FnMin
+	FnMax
+)
+
+//Aggregate reads the result of an aggregate function on a column into a variable.
+type Aggregate struct {
+	Function
+
+	//Column to aggregate, nil for FnCount.
+	Column Viewable
+
+	Into Variable
+}
+
+//Count returns an aggregate that reads the number of rows into the given variable, ie. an Int64.
+func Count(into Variable) Aggregate {
+	return Aggregate{FnCount, nil, into}
+}
+
+//Sum returns an aggregate that reads the sum of the column into the given variable.
+func Sum(column Viewable, into Variable) Aggregate {
+	return Aggregate{FnSum, column, into}
+}
+
+//Average returns an aggregate that reads the average of the column into the given variable, ie. a Float64.
+func Average(column Viewable, into Variable) Aggregate {
+	return Aggregate{FnAverage, column, into}
+}
+
+//Min returns an aggregate that reads the smallest value of the column into the given variable.
+func Min(column Viewable, into Variable) Aggregate {
+	return Aggregate{FnMin, column, into}
+}
+
+//Max returns an aggregate that reads the largest value of the column into the given variable.
+func Max(column Viewable, into Variable) Aggregate {
+	return Aggregate{FnMax, column, into}
+}
+
+//Grouper is returned by Filter.GroupBy
+type Grouper Filter
+
+//GroupBy groups the results of the filter by the given columns.
+//The groups are ordered by the values of these columns.
+func (f Filter) GroupBy(column Variable, columns ...Variable) Grouper {
+	f.Groups = append([]Variable{column}, columns...)
+	f.Offset = 0
+	f.Length = 0
+	return Grouper(f)
+}
+
+//Read reads the values of the grouped columns into those columns and the aggregates of each group into their variables.
+//Returns the number of groups.
+func (g Grouper) Read(aggregate Aggregate, aggregates ...Aggregate) (int, error) {
+	return Filter(g).search(g.driver).Aggregate(aggregate, aggregates...)
+}
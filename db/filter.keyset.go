@@ -0,0 +1,237 @@
+package db
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"reflect"
+)
+
+//keyset is the encoded form of a cursor token.
+type keyset struct {
+	Columns []string          `json:"c"`
+	Values  []json.RawMessage `json:"v"`
+}
+
+//sorters returns the sorters of the filter, followed by the key columns of the table that are not already sorted on.
+//The key columns make sure that every row has a unique position in the order.
+func (f Filter) sorters(t Table) []Sorter {
+	var sorters []Sorter
+	if f.Sort.Column != "" {
+		sorters = append(sorters, f.Sort)
+		sorters = append(sorters, f.Sorts...)
+	}
+
+	for i := 0; i < t.Columns(); i++ {
+		var column = t.Column(i)
+		if !column.Key() {
+			continue
+		}
+
+		var sorted bool
+		for _, sorter := range sorters {
+			if sorter.Column == column.Column() {
+				sorted = true
+				break
+			}
+		}
+		if !sorted {
+			sorters = append(sorters, Sorter{Table: t.Table(), Column: column.Column()})
+		}
+	}
+
+	return sorters
+}
+
+//keys returns the sorters of the filter on the given table, see sorters.
+//Returns ErrInvalidCursor if there are no sorters or if a sorter is not a column of the table or is nullable,
+//NULL values have no position in the order that the sorters could continue from.
+func (f Filter) keys(t Table) ([]Sorter, error) {
+	var sorters = f.sorters(t)
+	if len(sorters) == 0 {
+		return nil, ErrInvalidCursor
+	}
+
+	for _, sorter := range sorters {
+		column, ok := find(t, sorter.Column)
+		if !ok || column.Type().Kind() == reflect.Ptr {
+			return nil, ErrInvalidCursor
+		}
+	}
+
+	return sorters, nil
+}
+
+//table returns the table definition that the filter is viewing, falling back to the given viewer.
+func (f Filter) table(v Viewer) Table {
+	if f.View != nil {
+		return f.View
+	}
+	return v
+}
+
+//find returns the column of the table with the given name.
+func find(t Table, name string) (Column, bool) {
+	for i := 0; i < t.Columns(); i++ {
+		if column := t.Column(i); column.Column() == name {
+			return column, true
+		}
+	}
+	return nil, false
+}
+
+//seek returns a filter that continues after the row with the given values in the order of the given sorters.
+func (f Filter) seek(sorters []Sorter, values []interface{}) Filter {
+	var t = f.View
+
+	var cases = make([]Condition, len(sorters))
+	for i, sorter := range sorters {
+		var operator = OpGreaterThan
+		if sorter.Decreasing {
+			operator = OpLessThan
+		}
+
+		var equal = make([]Condition, 0, i+1)
+		for j := 0; j < i; j++ {
+			equal = append(equal, Condition{
+				Table:    sorters[j].Table,
+				Column:   sorters[j].Column,
+				Operator: OpEquals,
+				Value:    values[j],
+				View:     t,
+				driver:   f.driver,
+			})
+		}
+		equal = append(equal, Condition{
+			Table:    sorter.Table,
+			Column:   sorter.Column,
+			Operator: operator,
+			Value:    values[i],
+			View:     t,
+			driver:   f.driver,
+		})
+
+		cases[i] = All(equal[0], equal[1:]...)
+	}
+
+	f.Sort = sorters[0]
+	f.Sorts = sorters[1:]
+
+	if f.Table == "" {
+		f.Table = t.Table()
+	}
+	f.Conditions = append(append([]Condition(nil), f.Conditions...), Any(cases[0], cases[1:]...))
+
+	return f
+}
+
+//After returns a filter that only selects the results that come after the given row in the order of the filter's sorters.
+//This is keyset pagination, pass the last row of a page to select the next page. Unlike an offset, it stays fast on
+//large tables and does not skip or repeat rows when rows are inserted concurrently.
+//The key columns of the table are added to the sorters, so that the order is unique.
+//If the order cannot be continued from the row, every operation on the returned filter returns ErrInvalidCursor.
+func (f Filter) After(v Viewer) Filter {
+	if f.View == nil {
+		f.View = v
+		f.driver = v.Database()
+	}
+
+	sorters, err := f.keys(f.View)
+	if err != nil {
+		f.err = err
+		return f
+	}
+
+	var values = make([]interface{}, len(sorters))
+	for i, sorter := range sorters {
+		column, ok := find(v, sorter.Column)
+		if !ok {
+			f.err = ErrInvalidCursor
+			return f
+		}
+		values[i] = Mutate(v, column).Interface()
+	}
+
+	return f.seek(sorters, values)
+}
+
+//Cursor returns an opaque, URL-safe token for the position of the given row in the order of the filter's sorters.
+//Pass the token to AfterCursor on an identically sorted filter to select the results that come after the row.
+func (f Filter) Cursor(v Viewer) (string, error) {
+	sorters, err := f.keys(f.table(v))
+	if err != nil {
+		return "", err
+	}
+
+	var token keyset
+	for _, sorter := range sorters {
+		column, ok := find(v, sorter.Column)
+		if !ok {
+			return "", ErrInvalidCursor
+		}
+
+		encoded, err := json.Marshal(Mutate(v, column).Interface())
+		if err != nil {
+			return "", err
+		}
+
+		token.Columns = append(token.Columns, sorter.Column)
+		token.Values = append(token.Values, encoded)
+	}
+
+	encoded, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(encoded), nil
+}
+
+//AfterCursor returns a filter that only selects the results that come after the row that the given cursor token was created for.
+//An empty token returns the filter unchanged, so that the first page can be requested without a cursor.
+//Returns ErrInvalidCursor if the token is malformed or the sorters of the filter have changed.
+func (f Filter) AfterCursor(token string) (Filter, error) {
+	if token == "" {
+		return f, nil
+	}
+	if f.View == nil {
+		return f, ErrInvalidCursor
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return f, ErrInvalidCursor
+	}
+
+	var cursor keyset
+	if err := json.Unmarshal(decoded, &cursor); err != nil {
+		return f, ErrInvalidCursor
+	}
+
+	sorters, err := f.keys(f.View)
+	if err != nil {
+		return f, err
+	}
+	if len(cursor.Columns) != len(sorters) || len(cursor.Values) != len(sorters) {
+		return f, ErrInvalidCursor
+	}
+
+	var values = make([]interface{}, len(sorters))
+	for i, sorter := range sorters {
+		if cursor.Columns[i] != sorter.Column {
+			return f, ErrInvalidCursor
+		}
+
+		column, ok := find(f.View, sorter.Column)
+		if !ok {
+			return f, ErrInvalidCursor
+		}
+
+		var value = reflect.New(column.Type())
+		if err := json.Unmarshal(cursor.Values[i], value.Interface()); err != nil {
+			return f, ErrInvalidCursor
+		}
+		values[i] = value.Elem().Interface()
+	}
+
+	return f.seek(sorters, values), nil
+}
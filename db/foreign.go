@@ -0,0 +1,73 @@
+package db
+
+import (
+	"strings"
+)
+
+//Action is taken on the rows that reference a row, when that row is deleted.
+type Action int
+
+//Actions
+const (
+	//Restrict rejects the deletion of rows that are still referenced.
+	Restrict Action = iota
+
+	//Cascade deletes the rows that reference the deleted rows.
+	Cascade
+)
+
+//parseAction returns the action with the given name, as it is written in an ondelete= tag.
+//An empty name is the default, Restrict. Returns false if there is no such action.
+func parseAction(name string) (Action, bool) {
+	switch name {
+	case "", "restrict":
+		return Restrict, true
+	case "cascade":
+		return Cascade, true
+	default:
+		return Restrict, false
+	}
+}
+
+//ForeignKey is a column that references a column of another table.
+type ForeignKey struct {
+	Column string
+
+	//Table and column that are referenced.
+	Table, References string
+
+	OnDelete Action
+}
+
+//ForeignKeys returns the foreign keys of the table, these are declared by tagging columns with references=table.column
+//and optionally ondelete=cascade or ondelete=restrict (the default).
+func ForeignKeys(t Table) []ForeignKey {
+	var keys []ForeignKey
+
+	for i := 0; i < t.Columns(); i++ {
+		column := t.Column(i)
+
+		references, ok := column.(interface{ References() string })
+		if !ok || references.References() == "" {
+			continue
+		}
+
+		var key = ForeignKey{
+			Column:     column.Column(),
+			Table:      references.References(),
+			References: column.Column(),
+		}
+		if i := strings.LastIndexByte(key.Table, '.'); i >= 0 {
+			key.Table, key.References = key.Table[:i], key.Table[i+1:]
+		}
+
+		//The action was checked when the viewer was connected.
+		if action, ok := column.(interface{ OnDelete() string }); ok {
+			key.OnDelete, _ = parseAction(action.OnDelete())
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys
+}
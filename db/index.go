@@ -0,0 +1,66 @@
+package db
+
+//Index is an index on one or more columns of a table.
+type Index struct {
+	Name    string
+	Columns []string
+
+	//Unique indexes reject rows that have the same values as another row in all of the columns.
+	Unique bool
+}
+
+//Indexes returns the indexes of the table, these are declared by tagging columns with index or unique.
+//Each of these columns has an index of its own that is named after the table and the column, unless the tag names
+//an index (ie. `db:",index=by_owner_date"`) then the columns with that name share a composite index, in the order of the columns.
+//A composite index is unique if any of its columns are tagged with unique.
+//Key columns are left out unless they are part of a composite index, as databases already index them.
+func Indexes(t Table) []Index {
+	var indexes []Index
+	var named = make(map[string]int)
+
+	for i := 0; i < t.Columns(); i++ {
+		column := t.Column(i)
+
+		indexed, ok := column.(interface{ Indexed() bool })
+		if !ok || !indexed.Indexed() {
+			continue
+		}
+
+		var unique bool
+		if u, ok := column.(interface{ Unique() bool }); ok {
+			unique = u.Unique()
+		}
+
+		var name string
+		if n, ok := column.(interface{ IndexName() string }); ok {
+			name = n.IndexName()
+		}
+
+		if name == "" {
+			if column.Key() {
+				continue
+			}
+			indexes = append(indexes, Index{
+				Name:    t.Table() + "_" + column.Column(),
+				Columns: []string{column.Column()},
+				Unique:  unique,
+			})
+			continue
+		}
+
+		if j, ok := named[name]; ok {
+			indexes[j].Columns = append(indexes[j].Columns, column.Column())
+			indexes[j].Unique = indexes[j].Unique || unique
+			continue
+		}
+
+		named[name] = len(indexes)
+		indexes = append(indexes, Index{
+			Name:    name,
+			Columns: []string{column.Column()},
+			Unique:  unique,
+		})
+	}
+
+	return indexes
+}
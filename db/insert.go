@@ -1,47 +1,196 @@
 package db
 
 import (
+	"context"
+	"errors"
+	"reflect"
+
 	"github.com/google/uuid"
 )
 
-func insert(row Row) error {
+func insert(ctx context.Context, row Row) error {
 	if row.Row().Database() == nil {
 		return ErrDisconnectedViewer
 	}
-	return row.Row().Database().Insert(row)
+	return row.Row().Database().InsertContext(ctx, row)
 }
 
 //Insert inserts the given rows into their registered databases.
 func Insert(first Row, rows ...Row) error {
-	if err := insert(first); err != nil {
-		return err
+	return InsertContext(context.Background(), first, rows...)
+}
+
+//InsertContext inserts the given rows into their registered databases with the given context.
+//Consecutive rows of the same database are inserted together, so that the database can insert them atomically.
+func InsertContext(ctx context.Context, first Row, rows ...Row) error {
+	if len(rows) == 0 {
+		return insert(ctx, first)
+	}
+
+	var all = append([]Row{first}, rows...)
+
+	for start := 0; start < len(all); {
+		var database = all[start].Row().Database()
+		if database == nil {
+			return ErrDisconnectedViewer
+		}
+
+		var end = start + 1
+		for end < len(all) && all[end].Row().Database() == database {
+			end++
+		}
+
+		if err := database.InsertContext(ctx, all[start], all[start+1:end]...); err != nil {
+			return err
+		}
+
+		start = end
+	}
+	return nil
+}
+
+//Upsert inserts the given rows into their registered databases.
+//Rows with a key that already exists in the database are updated instead.
+func Upsert(first Row, rows ...Row) error {
+	return Overwriter{}.UpsertContext(context.Background(), first, rows...)
+}
+
+//UpsertContext inserts the given rows into their registered databases with the given context.
+//Rows with a key that already exists in the database are updated instead.
+func UpsertContext(ctx context.Context, first Row, rows ...Row) error {
+	return Overwriter{}.UpsertContext(ctx, first, rows...)
+}
+
+//Overwriter is returned by Overwrite.
+type Overwriter struct {
+	Columns []string
+}
+
+//Overwrite returns an Overwriter that only overwrites the given columns when upserting rows that already exist.
+func Overwrite(column Column, columns ...Column) Overwriter {
+	var o = Overwriter{Columns: []string{column.Column()}}
+	for _, column := range columns {
+		o.Columns = append(o.Columns, column.Column())
+	}
+	return o
+}
+
+//Upsert inserts the given rows into their registered databases.
+//Rows with a key that already exists in the database have the overwritten columns updated instead.
+func (o Overwriter) Upsert(first Row, rows ...Row) error {
+	return o.UpsertContext(context.Background(), first, rows...)
+}
+
+//UpsertContext inserts the given rows into their registered databases with the given context.
+//Rows with a key that already exists in the database have the overwritten columns updated instead.
+//Consecutive rows of the same database are upserted together, so that the database can upsert them atomically.
+func (o Overwriter) UpsertContext(ctx context.Context, first Row, rows ...Row) error {
+	var all = append([]Row{first}, rows...)
+
+	//The overwritten columns must belong to the table of every row, or they would silently be left alone.
+	for _, row := range all {
+		for _, name := range o.Columns {
+			if _, ok := find(row.Row(), name); !ok {
+				return errors.New("db.Overwrite: table " + row.Row().Table() + " has no column " + name)
+			}
+		}
 	}
-	for _, row := range rows {
-		if err := insert(row); err != nil {
+
+	for start := 0; start < len(all); {
+		var database = all[start].Row().Database()
+		if database == nil {
+			return ErrDisconnectedViewer
+		}
+
+		var end = start + 1
+		for end < len(all) && all[end].Row().Database() == database {
+			end++
+		}
+
+		if err := database.UpsertContext(ctx, o.Columns, all[start], all[start+1:end]...); err != nil {
 			return err
 		}
+
+		start = end
 	}
 	return nil
 }
 
+//Overwrites returns the indices of the columns of the insertion that an upsert of the given columns overwrites.
+//If no columns are given, all columns that are not keys are overwritten. Keys are never overwritten.
+func (insert *Insertion) Overwrites(columns []string) []int {
+	var overwrites []int
+	for i, name := range insert.Columns {
+		if insert.Uniques[i] {
+			continue
+		}
+		if len(columns) == 0 {
+			overwrites = append(overwrites, i)
+			continue
+		}
+		for _, column := range columns {
+			if column == name {
+				overwrites = append(overwrites, i)
+				break
+			}
+		}
+	}
+	return overwrites
+}
+
 //Insertion describes an insertion operation into the database.
 type Insertion struct {
 	Table
 	Columns []string
 	Uniques []bool
 	Values  []interface{}
+
+	//Autos are true for the columns that the database should generate a value for.
+	Autos []bool
+
+	//variables of the inserted row, nil if the row was not passed by pointer.
+	variables []Variable
+}
+
+//Generated writes the value that the database generated for the column at index i back into the inserted row.
+//Rows that were not passed by pointer are left as they are.
+func (insert *Insertion) Generated(i int, value interface{}) {
+	var generated = reflect.ValueOf(value).Convert(insert.Table.Column(i).Type())
+
+	insert.Values[i] = generated.Interface()
+	if insert.variables != nil {
+		reflect.ValueOf(insert.variables[i].Pointer()).Elem().Set(generated)
+	}
 }
 
 //Row makes the insetion operation insert the given row.
 func (insert *Insertion) Row(row Row) error {
 	insert.Table = row.Row()
 
+	viewer, ok := row.(Viewer)
+	var pointer = ok && reflect.ValueOf(row).Kind() == reflect.Ptr
+
 	for i := 0; i < insert.Table.Columns(); i++ {
 		col := insert.Table.Column(i)
 
 		insert.Columns = append(insert.Columns, col.Column())
 		insert.Uniques = append(insert.Uniques, col.Key())
 
+		var value Viewable
+		if pointer {
+			variable := Mutate(viewer, col)
+			if !viewer.Master() {
+				insert.variables = append(insert.variables, variable)
+			}
+			value = variable
+		} else {
+			value = LookAt(row, col)
+		}
+
+		auto, ok := col.(interface{ Auto() bool })
+		insert.Autos = append(insert.Autos, ok && auto.Auto() &&
+			reflect.DeepEqual(value.Interface(), reflect.Zero(col.Type()).Interface()))
+
 		id, ok := col.(UUID)
 		if !ok {
 			var uid *UUID
@@ -64,7 +213,8 @@ func (insert *Insertion) Row(row Row) error {
 			}
 		}
 
-		insert.Values = append(insert.Values, LookAt(row, col).Interface())
+		//Nullable values are copied, so that the row can be changed without changing the inserted value.
+		insert.Values = append(insert.Values, clone(reflect.ValueOf(value.Interface())).Interface())
 	}
 
 	return nil
@@ -0,0 +1,368 @@
+package db
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//Document is an encoded JSON value, it is the value of a JSON column.
+//The zero value is null.
+type Document string
+
+//NewDocument returns the given value encoded as a JSON document.
+func NewDocument(value interface{}) (Document, error) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return Document(encoded), nil
+}
+
+//Decode decodes the document into the value pointed to by v.
+func (d Document) Decode(v interface{}) error {
+	return json.Unmarshal([]byte(d.String()), v)
+}
+
+//String returns the encoded document, or "null" for the zero value.
+func (d Document) String() string {
+	if d == "" {
+		return "null"
+	}
+	return string(d)
+}
+
+//canonical returns the document with its object keys sorted and without whitespace, so that equal documents have the same representation.
+//Invalid documents are returned as they are.
+func (d Document) canonical() Document {
+	var value interface{}
+	if err := d.Decode(&value); err != nil {
+		return d
+	}
+	canonical, err := NewDocument(value)
+	if err != nil {
+		return d
+	}
+	return canonical
+}
+
+//Value implements driver.Valuer, documents are sent to the database as strings.
+func (d Document) Value() (driver.Value, error) {
+	if !json.Valid([]byte(d.String())) {
+		return nil, fmt.Errorf("invalid JSON document %q", string(d))
+	}
+	return d.String(), nil
+}
+
+//Scan implements sql.Scanner.
+func (d *Document) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case nil:
+		*d = ""
+	case []byte:
+		*d = Document(value)
+	case string:
+		*d = Document(value)
+	case driver.Valuer:
+		scanned, err := value.Value()
+		if err != nil {
+			return err
+		}
+		return d.Scan(scanned)
+	default:
+		//Drivers that decode JSON documents are re-encoded.
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return errors.New("db.Document: cannot scan " + reflect.TypeOf(src).String())
+		}
+		*d = Document(encoded)
+	}
+	return nil
+}
+
+//MarshalJSON writes the document as it is, so that it is embedded rather than quoted.
+func (d Document) MarshalJSON() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+//UnmarshalJSON stores a copy of the encoded JSON value.
+func (d *Document) UnmarshalJSON(data []byte) error {
+	if !json.Valid(data) {
+		return errors.New("db.Document: cannot unmarshal " + string(data))
+	}
+	*d = Document(data)
+	return nil
+}
+
+//Encode sets the JSON column to the encoding of the given value.
+func (j *JSON) Encode(value interface{}) error {
+	document, err := NewDocument(value)
+	if err != nil {
+		return err
+	}
+	j.Set(document)
+	return nil
+}
+
+//Decode decodes the value of the JSON column into the value pointed to by v.
+func (j JSON) Decode(v interface{}) error {
+	return j.value.Decode(v)
+}
+
+//Path returns the value at the given path within the JSON column, each key is either an object key or an array index.
+func (j JSON) Path(first string, rest ...string) Path {
+	return Path{Condition{
+		Table:  j.table,
+		View:   j.view,
+		driver: j.driver,
+
+		Column: j.column,
+		Path:   append([]string{first}, rest...),
+	}}
+}
+
+//HasKey returns a condition that is true if the JSON column is an object with the given key,
+//or an array that contains the given string.
+func (j JSON) HasKey(key string) Condition {
+	return Path{Condition{
+		Table:  j.table,
+		View:   j.view,
+		driver: j.driver,
+
+		Column: j.column,
+	}}.HasKey(key)
+}
+
+//Contains returns a condition that is true if the JSON column contains the given value,
+//ie. {"a":1,"b":2} contains {"a":1} and [1,2,3] contains [3,1].
+func (j JSON) Contains(value interface{}) Condition {
+	return Path{Condition{
+		Table:  j.table,
+		View:   j.view,
+		driver: j.driver,
+
+		Column: j.column,
+	}}.Contains(value)
+}
+
+//Path is a value within a JSON column that conditions can be placed on.
+//Conditions on paths that do not exist are false.
+type Path struct {
+	base Condition
+}
+
+//condition returns a condition on the path with the given operator and JSON encoded value.
+func (p Path) condition(operator Operator, value interface{}) Condition {
+	document, err := NewDocument(value)
+	if err != nil {
+		panic("db.Path: " + err.Error())
+	}
+
+	var c = p.base
+	c.Operator = operator
+	c.Value = document
+	return c
+}
+
+//Equals returns a condition that is true if the value at the path is equal to val.
+func (p Path) Equals(val interface{}) Condition {
+	return p.condition(OpEquals, val)
+}
+
+//NotEquals returns a condition that is true if the value at the path is not equal to val.
+func (p Path) NotEquals(val interface{}) Condition {
+	return p.condition(OpNotEquals, val)
+}
+
+//LessThan returns a condition that is true if the value at the path is less than val.
+//Only numbers and strings are ordered.
+func (p Path) LessThan(val interface{}) Condition {
+	return p.condition(OpLessThan, val)
+}
+
+//GreaterThan returns a condition that is true if the value at the path is greater than val.
+//Only numbers and strings are ordered.
+func (p Path) GreaterThan(val interface{}) Condition {
+	return p.condition(OpGreaterThan, val)
+}
+
+//HasKey returns a condition that is true if the value at the path is an object with the given key,
+//or an array that contains the given string.
+func (p Path) HasKey(key string) Condition {
+	var c = p.base
+	c.Operator = OpHasKey
+	c.Value = key
+	return c
+}
+
+//Contains returns a condition that is true if the value at the path contains val.
+func (p Path) Contains(val interface{}) Condition {
+	return p.condition(OpContainsJSON, val)
+}
+
+//walk returns the value at the given path within a decoded JSON value, or false if there is no such value.
+func walk(value interface{}, path []string) (interface{}, bool) {
+	for _, key := range path {
+		switch container := value.(type) {
+		case map[string]interface{}:
+			element, ok := container[key]
+			if !ok {
+				return nil, false
+			}
+			value = element
+		case []interface{}:
+			index, err := strconv.Atoi(key)
+			if err != nil || index < 0 || index >= len(container) {
+				return nil, false
+			}
+			value = container[index]
+		default:
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+//rankJSON returns the rank of the decoded JSON value in the order of postgres jsonb values,
+//null < string < number < boolean < array < object.
+func rankJSON(value interface{}) int {
+	switch value.(type) {
+	case nil:
+		return 0
+	case string:
+		return 1
+	case float64:
+		return 2
+	case bool:
+		return 3
+	case []interface{}:
+		return 4
+	default:
+		return 5
+	}
+}
+
+//orderJSON compares the decoded JSON values a and b in the order of postgres jsonb values,
+//it returns a negative number if a is less than b, zero if they are equal and a positive number otherwise.
+//Arrays and objects with fewer elements come first, the others are compared element by element,
+//objects in the order of their keys.
+func orderJSON(a, b interface{}) int {
+	if ra, rb := rankJSON(a), rankJSON(b); ra != rb {
+		return ra - rb
+	}
+
+	switch a := a.(type) {
+	case string:
+		return strings.Compare(a, b.(string))
+	case float64:
+		switch b := b.(float64); {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+		return 0
+	case bool:
+		switch b := b.(bool); {
+		case a == b:
+			return 0
+		case b:
+			return -1
+		}
+		return 1
+	case []interface{}:
+		var b = b.([]interface{})
+		if len(a) != len(b) {
+			return len(a) - len(b)
+		}
+		for i := range a {
+			if order := orderJSON(a[i], b[i]); order != 0 {
+				return order
+			}
+		}
+		return 0
+	case map[string]interface{}:
+		var b = b.(map[string]interface{})
+		if len(a) != len(b) {
+			return len(a) - len(b)
+		}
+
+		var keys = make([]string, 0, len(a)+len(b))
+		for key := range a {
+			keys = append(keys, key)
+		}
+		for key := range b {
+			if _, ok := a[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			x, okx := a[key]
+			y, oky := b[key]
+			if okx != oky {
+				//The object that is missing the key has a later key in its place.
+				if okx {
+					return -1
+				}
+				return 1
+			}
+			if order := orderJSON(x, y); order != 0 {
+				return order
+			}
+		}
+		return 0
+	}
+	return 0
+}
+
+//containsJSON returns true if the decoded JSON value a contains b, following the rules of postgres jsonb containment.
+func containsJSON(a, b interface{}) bool {
+	switch b := b.(type) {
+	case map[string]interface{}:
+		object, ok := a.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for key, value := range b {
+			if field, ok := object[key]; !ok || !containsJSON(field, value) {
+				return false
+			}
+		}
+		return true
+
+	case []interface{}:
+		array, ok := a.([]interface{})
+		if !ok {
+			return false
+		}
+	search:
+		for _, value := range b {
+			for _, element := range array {
+				if containsJSON(element, value) {
+					continue search
+				}
+			}
+			return false
+		}
+		return true
+
+	default:
+		//Arrays contain the primitive values within them.
+		if array, ok := a.([]interface{}); ok {
+			for _, element := range array {
+				if reflect.DeepEqual(element, b) {
+					return true
+				}
+			}
+			return false
+		}
+		return reflect.DeepEqual(a, b)
+	}
+}
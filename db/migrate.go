@@ -0,0 +1,295 @@
+package db
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+)
+
+//Migration is a versioned change to the schema or data of a database.
+//Up applies the change and Down reverts it, both run inside of a transaction together with the bookkeeping of the migration.
+type Migration struct {
+	Version int64
+	Name    string
+
+	Up, Down func(Tx) error
+}
+
+//Locker is implemented by drivers that can hold a named lock that is shared by every connection to the database.
+type Locker interface {
+	//Lock blocks until the named lock is acquired, the returned function releases it.
+	Lock(ctx context.Context, name string) (unlock func() error, err error)
+}
+
+//MigrationsViewer can be used to view the 'schema_migrations' table, which records the migrations that have been applied.
+type MigrationsViewer struct {
+	View `db:"schema_migrations"`
+
+	Version Int64 `db:",key"`
+	Name    String
+	Applied Time
+}
+
+//Migrator applies an ordered list of migrations to a database.
+type Migrator struct {
+	driver     Driver
+	ctx        context.Context
+	migrations []Migration
+}
+
+//Migrations returns a Migrator for the given database and migrations.
+//The migrations are ordered by their version, which must be unique and positive.
+func Migrations(driver Driver, first Migration, rest ...Migration) Migrator {
+	var sorted = append([]Migration{first}, rest...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Version < sorted[j].Version
+	})
+
+	for i, migration := range sorted {
+		if migration.Version <= 0 {
+			panic("db.Migrations: invalid version " + strconv.FormatInt(migration.Version, 10))
+		}
+		if i > 0 && sorted[i-1].Version == migration.Version {
+			panic("db.Migrations: duplicate version " + strconv.FormatInt(migration.Version, 10))
+		}
+	}
+
+	return Migrator{
+		driver:     driver,
+		migrations: sorted,
+	}
+}
+
+//WithContext returns a migrator that performs its operations with the given context.
+func (m Migrator) WithContext(ctx context.Context) Migrator {
+	if ctx == nil {
+		panic("nil context")
+	}
+	m.ctx = ctx
+	return m
+}
+
+//Context returns the context of the migrator, by default this is context.Background()
+func (m Migrator) Context() context.Context {
+	if m.ctx == nil {
+		return context.Background()
+	}
+	return m.ctx
+}
+
+//lock acquires the migration lock of the database, if the driver supports locking.
+//Without a lock, concurrent migrators are stopped by the key of the schema_migrations table instead,
+//the transaction of the second migrator is rolled back.
+func (m Migrator) lock() (func() error, error) {
+	if locker, ok := m.driver.(Locker); ok {
+		return locker.Lock(m.Context(), "schema_migrations")
+	}
+	return func() error { return nil }, nil
+}
+
+//applied returns the versions of the migrations that have been applied, in increasing order.
+//The caller must hold the lock.
+func (m Migrator) applied() ([]int64, error) {
+	var viewer MigrationsViewer
+	m.driver.Connect(&viewer)
+
+	if err := m.driver.Sync(&viewer); err != nil {
+		return nil, err
+	}
+
+	rows, err := If(viewer.Version.GreaterThan(0)).WithContext(m.Context()).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var row = viewer
+
+	var versions []int64
+	for rows.Next() {
+		if err := rows.Scan(&row); err != nil {
+			return nil, err
+		}
+		versions = append(versions, row.Version.Value())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i] < versions[j]
+	})
+
+	return versions, nil
+}
+
+//Version returns the version of the last migration that has been applied, 0 if there are none.
+func (m Migrator) Version() (int64, error) {
+	unlock, err := m.lock()
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	versions, err := m.applied()
+	if err != nil || len(versions) == 0 {
+		return 0, err
+	}
+	return versions[len(versions)-1], nil
+}
+
+//Latest applies all of the migrations that have not been applied yet.
+func (m Migrator) Latest() error {
+	return m.Migrate(m.migrations[len(m.migrations)-1].Version)
+}
+
+//Rollback reverts the last migration that has been applied.
+func (m Migrator) Rollback() error {
+	unlock, err := m.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	versions, err := m.applied()
+	if err != nil || len(versions) == 0 {
+		return err
+	}
+
+	var target int64
+	if len(versions) > 1 {
+		target = versions[len(versions)-2]
+	}
+
+	return m.migrate(versions, target)
+}
+
+//Migrate applies or reverts migrations, so that every migration up to and including the given version is applied
+//and none after it. Migrate(0) reverts every migration.
+func (m Migrator) Migrate(version int64) error {
+	unlock, err := m.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	versions, err := m.applied()
+	if err != nil {
+		return err
+	}
+
+	return m.migrate(versions, version)
+}
+
+//migrate applies or reverts migrations, given the versions that have already been applied.
+//The caller must hold the lock.
+func (m Migrator) migrate(versions []int64, target int64) error {
+	var applied = make(map[int64]bool, len(versions))
+	for _, version := range versions {
+		applied[version] = true
+	}
+
+	//Revert, newest first.
+	for i := len(versions) - 1; i >= 0 && versions[i] > target; i-- {
+		migration, ok := m.find(versions[i])
+		if !ok {
+			return ErrUnknownMigration
+		}
+		if migration.Down == nil {
+			return ErrIrreversibleMigration
+		}
+		if err := m.run(migration, false); err != nil {
+			return err
+		}
+	}
+
+	//Apply, oldest first.
+	for _, migration := range m.migrations {
+		if migration.Version > target {
+			break
+		}
+		if applied[migration.Version] {
+			continue
+		}
+		if err := m.run(migration, true); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//find returns the migration with the given version.
+func (m Migrator) find(version int64) (Migration, bool) {
+	for _, migration := range m.migrations {
+		if migration.Version == version {
+			return migration, true
+		}
+	}
+	return Migration{}, false
+}
+
+//run applies (or reverts) the migration inside of a transaction.
+func (m Migrator) run(migration Migration, up bool) error {
+	if err := m.Context().Err(); err != nil {
+		return err
+	}
+
+	var tx Tx
+	var err error
+	if beginner, ok := m.driver.(Beginner); ok {
+		tx, err = beginner.BeginContext(m.Context())
+	} else {
+		tx, err = m.driver.Begin()
+	}
+	if err != nil {
+		return err
+	}
+	defer tx.Close()
+
+	var record MigrationsViewer
+	tx.Connect(&record)
+
+	if up {
+		if err := migration.Up(tx); err != nil {
+			return &MigrationError{migration.Version, migration.Name, err}
+		}
+
+		var row = record
+		row.Version.Set(migration.Version)
+		row.Name.Set(migration.Name)
+		row.Applied.Set(time.Now().UTC())
+
+		if err := tx.InsertContext(m.Context(), row); err != nil {
+			return err
+		}
+	} else {
+		if err := migration.Down(tx); err != nil {
+			return &MigrationError{migration.Version, migration.Name, err}
+		}
+
+		if _, err := If(record.Version.Equals(migration.Version)).WithContext(m.Context()).Delete(); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+//MigrationError is returned when a migration fails.
+type MigrationError struct {
+	Version int64
+	Name    string
+
+	Err error
+}
+
+func (err *MigrationError) Error() string {
+	return "migration " + strconv.FormatInt(err.Version, 10) + " " + err.Name + ": " + err.Err.Error()
+}
+
+//Unwrap returns the error of the migration.
+func (err *MigrationError) Unwrap() error {
+	return err.Err
+}
@@ -0,0 +1,31 @@
+package db
+
+import "reflect"
+
+//Null returns true if the value is NULL, ie. nil or the nil pointer of a nullable column.
+func Null(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	var rvalue = reflect.ValueOf(value)
+	return rvalue.Kind() == reflect.Ptr && rvalue.IsNil()
+}
+
+//clone returns a copy of the value of a column, the value of a nullable column is copied
+//so that the copy does not point to the same value.
+func clone(value reflect.Value) reflect.Value {
+	if value.Kind() != reflect.Ptr || value.IsNil() {
+		return value
+	}
+	var copied = reflect.New(value.Type().Elem())
+	copied.Elem().Set(value.Elem())
+	return copied
+}
+
+//deref returns the value that the value of a nullable column points to, nil values are returned as they are.
+func deref(value interface{}) interface{} {
+	if rvalue := reflect.ValueOf(value); rvalue.Kind() == reflect.Ptr && !rvalue.IsNil() {
+		return rvalue.Elem().Interface()
+	}
+	return value
+}
@@ -0,0 +1,220 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+//Opener opens a database driver with the given arguments.
+type Opener func(args ...string) (Driver, error)
+
+var openers = make(map[string]Opener)
+var openersMutex sync.RWMutex
+
+func init() {
+	Register("builtin", func(args ...string) (Driver, error) {
+		if len(args) == 0 {
+			return Builtin(""), nil
+		}
+		return Builtin(strings.TrimPrefix(args[0], "builtin://")), nil
+	})
+}
+
+//Register makes a database driver available by the provided name so that it can be opened with Open.
+//Driver packages should call this from an init function.
+//If Register is called twice with the same name or if opener is nil, it panics.
+func Register(name string, opener Opener) {
+	openersMutex.Lock()
+	defer openersMutex.Unlock()
+
+	if opener == nil {
+		panic("db.Register: opener is nil")
+	}
+	if _, exists := openers[name]; exists {
+		panic("db.Register: called twice for driver " + name)
+	}
+
+	openers[name] = opener
+}
+
+//Drivers returns a sorted list of the names of the registered drivers.
+func Drivers() []string {
+	openersMutex.RLock()
+	defer openersMutex.RUnlock()
+
+	var names = make([]string, 0, len(openers))
+	for name := range openers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+//Open opens a database based on the provided optional arguments.
+//The first argument is a database name and subsequent arguments are passed to it.
+//The first argument may also be a URL, in which case the URL's scheme is the database name and the URL is passed to it.
+//ie. db.Open("postgres", "host=localhost") or db.Open("postgres://localhost")
+//If no arguments are provided, a builtin database is used.
+//
+//If the database cannot be opened, the returned Driver returns the error from every operation, including Close.
+func Open(args ...string) Driver {
+	if len(args) == 0 {
+		return Builtin("")
+	}
+
+	var name = args[0]
+
+	if i := strings.Index(name, "://"); i > 0 {
+		name = name[:i]
+	} else {
+		args = args[1:]
+	}
+
+	openersMutex.RLock()
+	opener, ok := openers[name]
+	openersMutex.RUnlock()
+
+	if !ok {
+		return unavailable{fmt.Errorf("db.Open: unknown driver %q (forgotten import?)", name)}
+	}
+
+	driver, err := opener(args...)
+	if err != nil {
+		return unavailable{fmt.Errorf("db.Open: %v: %w", name, err)}
+	}
+
+	return driver
+}
+
+//unavailable is a Driver that could not be opened.
+type unavailable struct {
+	error
+}
+
+//Connect connects the given viewers to this driver so that their operations return the error.
+func (u unavailable) Connect(first Viewer, more ...Viewer) Driver {
+	Connect(first, u)
+	for _, viewer := range more {
+		Connect(viewer, u)
+	}
+	return u
+}
+
+//Sync returns the error.
+func (u unavailable) Sync(Table, ...Table) error {
+	return u.error
+}
+
+//Plan returns the error.
+func (u unavailable) Plan(Table, ...Table) (Diff, error) {
+	return Diff{}, u.error
+}
+
+//Insert returns the error.
+func (u unavailable) Insert(Row, ...Row) error {
+	return u.error
+}
+
+//InsertContext returns the error.
+func (u unavailable) InsertContext(context.Context, Row, ...Row) error {
+	return u.error
+}
+
+//UpsertContext returns the error.
+func (u unavailable) UpsertContext(context.Context, []string, Row, ...Row) error {
+	return u.error
+}
+
+//Delete returns the error.
+func (u unavailable) Delete(Table, ...Table) error {
+	return u.error
+}
+
+//Empty returns the error.
+func (u unavailable) Empty(Table, ...Table) error {
+	return u.error
+}
+
+//Search returns results that return the error.
+func (u unavailable) Search(Filter) Results {
+	return failure{u.error}
+}
+
+//Begin returns the error.
+func (u unavailable) Begin() (Tx, error) {
+	return nil, u.error
+}
+
+//Close returns the error.
+func (u unavailable) Close() error {
+	return u.error
+}
+
+//failure is the Results of an unavailable Driver.
+type failure struct {
+	error
+}
+
+//MarshalJSON returns the error.
+func (f failure) MarshalJSON() ([]byte, error) {
+	return nil, f.error
+}
+
+//Update returns the error.
+func (f failure) Update(Update, ...Update) (int, error) {
+	return 0, f.error
+}
+
+//Delete returns the error.
+func (f failure) Delete() (int, error) {
+	return 0, f.error
+}
+
+//Get returns the error.
+func (f failure) Get(Variable, ...Variable) (int, error) {
+	return 0, f.error
+}
+
+//Count returns the error.
+func (f failure) Count(Viewable) (int, error) {
+	return 0, f.error
+}
+
+//Sum returns the error.
+func (f failure) Sum(Variable) error {
+	return f.error
+}
+
+//Average returns the error.
+func (f failure) Average(Viewable) (float64, error) {
+	return 0, f.error
+}
+
+//Min returns the error.
+func (f failure) Min(Variable) error {
+	return f.error
+}
+
+//Max returns the error.
+func (f failure) Max(Variable) error {
+	return f.error
+}
+
+//CountDistinct returns the error.
+func (f failure) CountDistinct(Viewable) (int, error) {
+	return 0, f.error
+}
+
+//Aggregate returns the error.
+func (f failure) Aggregate(Aggregate, ...Aggregate) (int, error) {
+	return 0, f.error
+}
+
+//Rows returns the error.
+func (f failure) Rows() (Rows, error) {
+	return nil, f.error
+}
@@ -0,0 +1,159 @@
+package db
+
+import (
+	"strings"
+)
+
+//Difference is a kind of difference between a table and the database.
+type Difference int
+
+//Differences
+const (
+	//MissingTable means that the table does not exist in the database, Sync creates it.
+	MissingTable Difference = iota + 1
+
+	//MissingColumn means that the column does not exist in the database, Sync adds it.
+	MissingColumn
+
+	//TypeMismatch means that the column has a different type in the database, Sync fails.
+	TypeMismatch
+
+	//KeyMismatch means that the key columns of the table are different in the database, Sync fails.
+	KeyMismatch
+
+	//ExtraColumn means that the database has a column that is not part of the table, Sync leaves it alone.
+	ExtraColumn
+
+	//MissingIndex means that the index does not exist in the database, Sync creates it.
+	MissingIndex
+
+	//MissingForeignKey means that the foreign key constraint does not exist in the database, Sync adds it.
+	MissingForeignKey
+)
+
+func (d Difference) String() string {
+	switch d {
+	case MissingTable:
+		return "missing table"
+	case MissingColumn:
+		return "missing column"
+	case TypeMismatch:
+		return "type mismatch"
+	case KeyMismatch:
+		return "key mismatch"
+	case ExtraColumn:
+		return "extra column"
+	case MissingIndex:
+		return "missing index"
+	case MissingForeignKey:
+		return "missing foreign key"
+	default:
+		return "unknown difference"
+	}
+}
+
+//Change is a difference between a table and the database.
+type Change struct {
+	Difference
+
+	Table, Column string
+
+	//Index is the name of the index, for MissingIndex.
+	Index string
+
+	//Want is what the table defines, Have is what the database has, ie. the types of a column.
+	Want, Have string
+}
+
+//Conflict returns true if Sync cannot resolve the change.
+func (c Change) Conflict() bool {
+	return c.Difference == TypeMismatch || c.Difference == KeyMismatch
+}
+
+func (c Change) String() string {
+	var s = c.Table
+	if c.Column != "" {
+		s += "." + c.Column
+	}
+	if c.Index != "" {
+		s += " " + c.Index
+	}
+	s += ": " + c.Difference.String()
+	if c.Want != "" || c.Have != "" {
+		s += " (want " + c.Want + ", have " + c.Have + ")"
+	}
+	return s
+}
+
+//Diff is returned by Plan, it describes the changes that Sync would make to the database.
+type Diff struct {
+	Changes []Change
+
+	//Statements that Sync would run, for drivers that are backed by SQL.
+	Statements []string
+}
+
+//Empty returns true if the tables are in sync with the database.
+func (d Diff) Empty() bool {
+	return len(d.Changes) == 0
+}
+
+//Conflicts returns the changes that Sync cannot resolve.
+func (d Diff) Conflicts() []Change {
+	var conflicts []Change
+	for _, change := range d.Changes {
+		if change.Conflict() {
+			conflicts = append(conflicts, change)
+		}
+	}
+	return conflicts
+}
+
+//Err returns a *SchemaError if the diff has any conflicts, else nil.
+func (d Diff) Err() error {
+	if conflicts := d.Conflicts(); len(conflicts) > 0 {
+		return &SchemaError{conflicts}
+	}
+	return nil
+}
+
+//SchemaError is returned by Sync when a table does not match up with the database.
+type SchemaError struct {
+	Conflicts []Change
+}
+
+func (err *SchemaError) Error() string {
+	var conflicts = make([]string, len(err.Conflicts))
+	for i, conflict := range err.Conflicts {
+		conflicts[i] = conflict.String()
+	}
+	return "schema mismatch: " + strings.Join(conflicts, "; ")
+}
+
+//Plan returns the changes that Sync would make to the database of each table, without making them.
+func Plan(table Table, tables ...Table) (Diff, error) {
+	var result Diff
+
+	plan := func(table Table) error {
+		if table.Database() == nil {
+			return ErrDisconnectedViewer
+		}
+		diff, err := table.Database().Plan(table)
+		if err != nil {
+			return err
+		}
+		result.Changes = append(result.Changes, diff.Changes...)
+		result.Statements = append(result.Statements, diff.Statements...)
+		return nil
+	}
+
+	if err := plan(table); err != nil {
+		return Diff{}, err
+	}
+	for _, table := range tables {
+		if err := plan(table); err != nil {
+			return Diff{}, err
+		}
+	}
+	return result, nil
+}
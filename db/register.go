@@ -3,10 +3,38 @@ package db
 import (
 	"errors"
 	"reflect"
+	"strconv"
 	"strings"
 	"unsafe"
 )
 
+//tag holds the options of a column that are set in its db tag.
+type tag struct {
+	//key columns are unique and identify the row.
+	key bool
+
+	//index columns are indexed by drivers that support it.
+	index bool
+
+	//auto columns are integer keys that the database generates a value for, if the inserted value is zero.
+	auto bool
+
+	//unique columns are indexed and no two rows may have the same value.
+	unique bool
+
+	//name of the composite index that the column is part of, if any.
+	name string
+
+	//references is the "table.column" that the column is a foreign key of, if any.
+	references string
+
+	//ondelete is the action to take when the referenced row is deleted.
+	ondelete string
+
+	//precision is the total number of digits of a decimal column and scale is the number of those digits after the decimal point.
+	precision, scale int
+}
+
 //Connect initialises and connects the given viewer.
 func Connect(viewer Viewer, driver Driver) error {
 	if viewer.Master() {
@@ -34,6 +62,23 @@ func Connect(viewer Viewer, driver Driver) error {
 		}
 	}
 
+	//Columns with invalid tags leave the viewer connected to a driver that returns the error from every operation.
+	var failed error
+	var parsed = make([]tag, rvalue.NumField())
+	var names = make([]string, rvalue.NumField())
+	for i := 0; i < rvalue.NumField(); i++ {
+		if setter, ok := rvalue.Field(i).Addr().Interface().(value); ok {
+			var err error
+			names[i], parsed[i], err = parseTag(vtype.Field(i), setter)
+			if err != nil && failed == nil {
+				failed = err
+			}
+		}
+	}
+	if failed != nil {
+		driver = unavailable{failed}
+	}
+
 	var columns []Column
 
 	for i := 0; i < rvalue.NumField(); i++ {
@@ -41,27 +86,12 @@ func Connect(viewer Viewer, driver Driver) error {
 		var field = vtype.Field(i)
 
 		if setter, ok := rvalue.Addr().Interface().(value); ok {
-			var name = field.Name
-			var key bool
-
-			//The name can be overriden in the tag.
-			//Further tags include key and unique
-			if tag, ok := field.Tag.Lookup("db"); ok {
-				args := strings.Split(tag, ",")
-				if args[0] != "" {
-					name = args[0]
-				}
-				if len(args) > 0 {
-					if args[1] == "key" {
-						key = true
-					}
-				}
-			}
+			var name, options = names[i], parsed[i]
 
 			setter.setprivate(
 				table.name, name,
 				field.Offset,
-				key,
+				options,
 				driver,
 				viewer,
 			)
@@ -74,7 +104,7 @@ func Connect(viewer Viewer, driver Driver) error {
 				t.WordIndex.setprivate(
 					table.name, name+"_index",
 					field.Offset+unsafe.Offsetof(t.WordIndex),
-					false,
+					tag{},
 					driver,
 					viewer,
 				)
@@ -98,6 +128,10 @@ func Connect(viewer Viewer, driver Driver) error {
 
 	viewer.SetDriver(driver)
 
+	if failed != nil {
+		return failed
+	}
+
 	/*return connections[model.getModel().Connection].Verify(Schema{
 		Table:   model.GetTable(),
 		Columns: columns,
@@ -105,3 +139,88 @@ func Connect(viewer Viewer, driver Driver) error {
 
 	return nil
 }
+
+//parseTag returns the column name and options that are set in the db tag of the given field.
+//The name can be overriden in the tag.
+//Further tags include key, index, unique and auto
+//index=name and unique=name add the column to a composite index with that name.
+//references=table.column makes the column a foreign key, with ondelete=cascade or ondelete=restrict.
+//precision=n and scale=n set the number of digits of a decimal column.
+func parseTag(field reflect.StructField, setter value) (name string, options tag, err error) {
+	name = field.Name
+
+	if tag, ok := field.Tag.Lookup("db"); ok {
+		args := strings.Split(tag, ",")
+		if args[0] != "" {
+			name = args[0]
+		}
+		for _, arg := range args[1:] {
+			var option, value = arg, ""
+			if i := strings.IndexByte(arg, '='); i >= 0 {
+				option, value = arg[:i], arg[i+1:]
+			}
+
+			switch option {
+			case "key", "auto":
+				if value != "" {
+					return name, options, errors.New("db.Register: " + option + " of " + field.Name + " does not take a value: " + arg)
+				}
+				if option == "key" {
+					options.key = true
+				} else {
+					options.auto = true
+				}
+			case "index":
+				options.index = true
+				if value != "" {
+					options.name = value
+				}
+			case "unique":
+				options.index = true
+				options.unique = true
+				if value != "" {
+					options.name = value
+				}
+			case "references":
+				options.references = value
+			case "ondelete":
+				if _, ok := parseAction(value); !ok {
+					return name, options, errors.New("db.Register: invalid ondelete of " + field.Name + ": " + value + ", expected cascade or restrict")
+				}
+				options.ondelete = value
+			case "precision", "scale":
+				switch setter.(type) {
+				case *Decimal, *NullDecimal:
+				default:
+					return name, options, errors.New("db.Register: " + option + " of " + field.Name + " requires a Decimal or a NullDecimal column")
+				}
+				digits, err := strconv.Atoi(value)
+				if err != nil || digits < 0 {
+					return name, options, errors.New("db.Register: invalid " + option + " of " + field.Name + ": " + value)
+				}
+				if option == "precision" {
+					options.precision = digits
+				} else {
+					options.scale = digits
+				}
+			default:
+				return name, options, errors.New("db.Register: unknown option " + strconv.Quote(option) + " in the db tag of " + field.Name)
+			}
+		}
+	}
+
+	if options.ondelete != "" && options.references == "" {
+		return name, options, errors.New("db.Register: ondelete of " + field.Name + " requires references=table.column")
+	}
+
+	//Only integer columns can be generated.
+	if options.auto {
+		switch setter.(type) {
+		case *Int64, *Int32:
+		default:
+			return name, options, errors.New("db.Register: auto column " + field.Name + " must be an Int64 or an Int32")
+		}
+	}
+
+	return name, options, nil
+}
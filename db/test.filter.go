@@ -1,7 +1,6 @@
 package db
 
 import (
-	"math"
 	"testing"
 
 	"qlova.org/should"
@@ -210,6 +209,104 @@ func (ts *TestSuite) TestResultsCount() {
 	should.Be(0)(count).Test(t)
 }
 
+//TestResultsCompare tests the comparison operators.
+func (ts *TestSuite) TestResultsCompare() {
+	defer ts.isolation()()
+
+	var t = ts.T()
+
+	//Setup a few rows.
+	var test = ts.dummyRows()
+
+	for _, expectation := range []struct {
+		Condition
+		count int
+	}{
+		{test.ID.GreaterThan(1), 2},
+		{test.ID.LessOrEqual(2), 2},
+		{test.ID.GreaterOrEqual(3), 1},
+		{test.ID.Between(1, 2), 2},
+		{test.ID.In(1, 3, 4), 2},
+		{test.ID.In(), 0},
+		{test.ID.NotIn(2), 2},
+		{test.ID.NotIn(), 3},
+		{test.Value.GreaterThan("Hello"), 2},
+		{test.Value.In("Hello"), 1},
+	} {
+		count, err := If(expectation.Condition).Count(test.ID)
+		should.NotError(err).Test(t)
+		should.Be(expectation.count)(count).Test(t)
+	}
+}
+
+//TestResultsMatch tests the LessThan, Contains and HasPrefix operators.
+func (ts *TestSuite) TestResultsMatch() {
+	defer ts.isolation()()
+
+	var t = ts.T()
+
+	//Setup a few rows.
+	var test = ts.dummyRows()
+
+	test.ID.Set(4)
+	test.Value.Set("100%")
+	should.NotError(Insert(test)).Test(t)
+
+	for _, expectation := range []struct {
+		Condition
+		count int
+	}{
+		{test.ID.LessThan(3), 2},
+		{test.ID.LessThan(1), 0},
+		{test.Value.Contains("orl"), 2},
+		{test.Value.Contains("o"), 3},
+		{test.Value.Contains("x"), 0},
+		{test.Value.HasPrefix("He"), 1},
+		{test.Value.HasPrefix("llo"), 0},
+
+		//Wildcards are matched literally.
+		{test.Value.Contains("%"), 1},
+		{test.Value.Contains("_"), 0},
+		{test.Value.HasPrefix("_ello"), 0},
+		{test.Value.HasPrefix("1%"), 0},
+		{test.Value.HasPrefix("100%"), 1},
+	} {
+		count, err := If(expectation.Condition).Count(test.ID)
+		should.NotError(err).Test(t)
+		should.Be(expectation.count)(count).Test(t)
+	}
+}
+
+//TestResultsConditions tests nested condition trees.
+func (ts *TestSuite) TestResultsConditions() {
+	defer ts.isolation()()
+
+	var t = ts.T()
+
+	//Setup a few rows.
+	var test = ts.dummyRows()
+
+	for _, expectation := range []struct {
+		Condition
+		count int
+	}{
+		{Either(test.ID.Equals(1), test.ID.Equals(3)), 2},
+		{Both(test.Value.Equals("World"), test.ID.Equals(3)), 1},
+		{Switch(test.ID.Equals(1), test.ID.Equals(2), test.ID.Equals(3)), 3},
+		{Not(test.ID.Equals(1)), 2},
+		{Not(Either(test.ID.Equals(1), test.ID.Equals(3))), 1},
+		{All(test.Value.Equals("World")), 2},
+		{All(test.Value.Equals("World"), Not(test.ID.Equals(2))), 1},
+		{Any(test.ID.Equals(1), All(test.Value.Equals("World"), test.ID.GreaterThan(2))), 2},
+		{Not(Any(test.ID.Equals(1), test.ID.Equals(2), test.ID.Equals(3))), 0},
+		{All(Any(test.ID.Equals(1), test.ID.Equals(2)), Any(test.ID.Equals(2), test.ID.Equals(3))), 1},
+	} {
+		count, err := If(expectation.Condition).Count(test.ID)
+		should.NotError(err).Test(t)
+		should.Be(expectation.count)(count).Test(t)
+	}
+}
+
 //TestResultsSum tests the result sum function.
 func (ts *TestSuite) TestResultsSum() {
 	defer ts.isolation()()
@@ -246,9 +343,41 @@ func (ts *TestSuite) TestResultsAverage() {
 	should.NotError(err).Test(t)
 	should.Be(float64(2.5))(avg).Test(t)
 
-	avg, err = If(test.Value.Equals("")).Average(test.ID)
+	_, err = If(test.Value.Equals("")).Average(test.ID)
+	should.Be(ErrNotFound)(err).Test(t)
+}
+
+//TestResultsExtremes tests Min, Max and CountDistinct.
+func (ts *TestSuite) TestResultsExtremes() {
+	defer ts.isolation()()
+
+	var t = ts.T()
+
+	//Setup a few rows.
+	var test = ts.dummyRows()
+
+	should.NotError(If(test.Value.NotEquals("")).Min(&test.ID)).Test(t)
+	should.Be(int64(1))(test.ID.Value()).Test(t)
+
+	should.NotError(If(test.Value.NotEquals("")).Max(&test.ID)).Test(t)
+	should.Be(int64(3))(test.ID.Value()).Test(t)
+
+	should.NotError(If(test.Value.Equals("World")).Min(&test.Value)).Test(t)
+	should.Be("World")(test.Value.Value()).Test(t)
+
+	should.NotError(If(test.Value.NotEquals("")).Max(&test.Value)).Test(t)
+	should.Be("World")(test.Value.Value()).Test(t)
+
+	should.Be(ErrNotFound)(If(test.Value.Equals("")).Min(&test.ID)).Test(t)
+	should.Be(ErrNotFound)(If(test.Value.Equals("")).Max(&test.ID)).Test(t)
+
+	count, err := If(test.Value.NotEquals("")).CountDistinct(test.Value)
+	should.NotError(err).Test(t)
+	should.Be(2)(count).Test(t)
+
+	count, err = If(test.Value.Equals("")).CountDistinct(test.Value)
 	should.NotError(err).Test(t)
-	should.Be(true)(math.IsNaN(avg)).Test(t)
+	should.Be(0)(count).Test(t)
 }
 
 //TestResultsSlice tests filter slicing.
@@ -298,3 +427,184 @@ func (ts *TestSuite) TestResultsNotFound() {
 		If(test.Value.Equals("DOES NOT EXIST")).SortBy(test.ID.Increasing()).Get(&test),
 	).Test(t)
 }
+
+//TestResultsRows tests reading results with a cursor.
+func (ts *TestSuite) TestResultsRows() {
+	defer ts.isolation()()
+
+	var t = ts.T()
+
+	//Setup a few rows.
+	var test = ts.dummyRows()
+
+	rows, err := If(test.Value.Equals("World")).SortBy(test.ID.Increasing()).Rows()
+	should.NotError(err).Test(t)
+
+	var ids []int64
+	for rows.Next() {
+		should.NotError(rows.Scan(&test)).Test(t)
+		ids = append(ids, test.ID.Value())
+	}
+	should.NotError(rows.Err()).Test(t)
+	should.NotError(rows.Close()).Test(t)
+
+	should.Be([]int64{2, 3})(ids).Test(t)
+
+	rows, err = If(test.Value.NotEquals("")).SortBy(test.ID.Increasing()).Slice(1, 1).Rows()
+	should.NotError(err).Test(t)
+
+	should.Be(true)(rows.Next()).Test(t)
+	should.NotError(rows.Scan(&test)).Test(t)
+	should.Be(int64(2))(test.ID.Value()).Test(t)
+	should.Be(false)(rows.Next()).Test(t)
+	should.NotError(rows.Close()).Test(t)
+}
+
+//TestResultsRowsDelete tests deleting rows while iterating over them.
+func (ts *TestSuite) TestResultsRowsDelete() {
+	defer ts.isolation()()
+
+	var t = ts.T()
+
+	//Setup a few rows.
+	var test = ts.dummyRows()
+
+	rows, err := If(test.Value.NotEquals("")).SortBy(test.ID.Increasing()).Rows()
+	should.NotError(err).Test(t)
+
+	var ids []int64
+	for rows.Next() {
+		should.NotError(rows.Scan(&test)).Test(t)
+		ids = append(ids, test.ID.Value())
+
+		_, err := If(test.ID.Equals(test.ID.Value())).Delete()
+		should.NotError(err).Test(t)
+	}
+	should.NotError(rows.Err()).Test(t)
+	should.NotError(rows.Close()).Test(t)
+
+	should.Be([]int64{1, 2, 3})(ids).Test(t)
+
+	count, err := If(test.Value.NotEquals("")).Count(test.ID)
+	should.NotError(err).Test(t)
+	should.Be(0)(count).Test(t)
+}
+
+//TestResultsRange tests iterating over results one page at a time.
+func (ts *TestSuite) TestResultsRange() {
+	defer ts.isolation()()
+
+	var t = ts.T()
+
+	//Setup a few rows.
+	var test = ts.dummyRows()
+
+	for _, size := range []int{1, 2, 3, 4} {
+		var ids []int64
+
+		i := If(test.Value.NotEquals("")).SortBy(test.ID.Increasing()).Range(&test, size)
+		for i.Next() {
+			ids = append(ids, test.ID.Value())
+		}
+		should.NotError(i.Err()).Test(t)
+
+		should.Be([]int64{1, 2, 3})(ids).Test(t)
+	}
+
+	i := If(test.Value.Equals("World")).SortBy(test.ID.Increasing()).Range(&test, 1)
+	should.Be(true)(i.Next()).Test(t)
+	should.Be(int64(2))(test.ID.Value()).Test(t)
+	should.NotError(i.Close()).Test(t)
+	should.Be(false)(i.Next()).Test(t)
+}
+
+//TestResultsAfter tests keyset pagination.
+func (ts *TestSuite) TestResultsAfter() {
+	defer ts.isolation()()
+
+	var t = ts.T()
+
+	//Setup a few rows.
+	var test = ts.dummyRows()
+
+	var filter = If(test.Value.NotEquals("")).SortBy(test.Value.Decreasing())
+
+	//Ties on Value are broken by the key.
+	should.NotError(filter.Get(&test)).Test(t)
+	should.Be(int64(2))(test.ID.Value()).Test(t)
+
+	should.NotError(filter.After(&test).Get(&test)).Test(t)
+	should.Be(int64(3))(test.ID.Value()).Test(t)
+
+	token, err := filter.Cursor(&test)
+	should.NotError(err).Test(t)
+
+	next, err := filter.AfterCursor(token)
+	should.NotError(err).Test(t)
+	should.NotError(next.Get(&test)).Test(t)
+	should.Be(int64(1))(test.ID.Value()).Test(t)
+
+	should.Be(ErrNotFound)(next.After(&test).Get(&test)).Test(t)
+
+	_, err = filter.AfterCursor("not a cursor")
+	should.Be(ErrInvalidCursor)(err).Test(t)
+
+	_, err = filter.SortBy(test.ID.Increasing()).AfterCursor(token)
+	should.Be(ErrInvalidCursor)(err).Test(t)
+
+	//Results without an order, or ordered by a nullable column, cannot be continued.
+	var Unordered struct {
+		View `db:"unordered"`
+
+		Value NullString
+	}
+	ts.Driver.Connect(&Unordered)
+
+	var row = Unordered
+	should.Be(ErrInvalidCursor)(If(Unordered.Value.IsNull()).After(&row).Get(&row)).Test(t)
+
+	var nullable = If(Unordered.Value.IsNull()).SortBy(Unordered.Value.Increasing())
+	should.Be(ErrInvalidCursor)(nullable.After(&row).Get(&row)).Test(t)
+
+	_, err = nullable.Cursor(&row)
+	should.Be(ErrInvalidCursor)(err).Test(t)
+}
+
+//TestResultsGroupBy tests aggregating groups of results.
+func (ts *TestSuite) TestResultsGroupBy() {
+	defer ts.isolation()()
+
+	var t = ts.T()
+
+	//Setup a few rows.
+	var test = ts.dummyRows()
+
+	var count, sum, max Int64
+	var avg Float64
+
+	groups, err := If(test.Value.NotEquals("")).GroupBy(&test.Value).Read(
+		Count(&count),
+		Sum(test.ID, &sum),
+		Average(test.ID, &avg),
+		Max(test.ID, &max),
+	)
+	should.NotError(err).Test(t)
+	should.Be(2)(groups).Test(t)
+
+	should.Be(true)(test.Value.Index(0) && count.Index(0) && sum.Index(0) && avg.Index(0) && max.Index(0)).Test(t)
+	should.Be("Hello")(test.Value.Value()).Test(t)
+	should.Be(int64(1))(count.Value()).Test(t)
+	should.Be(int64(1))(sum.Value()).Test(t)
+	should.Be(float64(1))(avg.Value()).Test(t)
+	should.Be(int64(1))(max.Value()).Test(t)
+
+	should.Be(true)(test.Value.Index(1) && count.Index(1) && sum.Index(1) && avg.Index(1) && max.Index(1)).Test(t)
+	should.Be("World")(test.Value.Value()).Test(t)
+	should.Be(int64(2))(count.Value()).Test(t)
+	should.Be(int64(5))(sum.Value()).Test(t)
+	should.Be(float64(2.5))(avg.Value()).Test(t)
+	should.Be(int64(3))(max.Value()).Test(t)
+
+	_, err = If(test.Value.Equals("")).GroupBy(&test.Value).Read(Count(&count))
+	should.Be(ErrNotFound)(err).Test(t)
+}
@@ -1,6 +1,10 @@
 package db
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+
 	"qlova.org/should"
 	"qlova.org/should/test"
 )
@@ -100,6 +104,136 @@ func (ts *TestSuite) TestSync() {
 	).Test(ts.T())
 }
 
+func (ts *TestSuite) TestResync() {
+	defer ts.isolation()()
+
+	var t = ts.T()
+
+	//Syncing a table that already has rows keeps them, and rows can still be inserted afterwards.
+	var test = ts.dummyRows()
+	should.NotError(Sync(ts.Testable)).Test(t)
+
+	test.ID.Set(4)
+	should.NotError(Insert(test)).Test(t)
+
+	should.NotError(Sync(ts.Testable)).Test(t)
+
+	test.ID.Set(5)
+	should.NotError(Insert(test)).Test(t)
+
+	count, err := If(ts.Testable.ID.NotEquals(0)).Count(ts.Testable.ID)
+	should.NotError(err).Test(t)
+	should.Be(5)(count).Test(t)
+}
+
+//TestPlan tests that the driver reports the differences between a table and the database.
+func (ts *TestSuite) TestPlan() {
+	defer ts.isolation()()
+
+	var t = ts.T()
+
+	diff, err := Plan(ts.Testable)
+	should.NotError(err).Test(t)
+	should.Be(true)(diff.Empty()).Test(t)
+
+	//Missing columns are added by Sync.
+	var Extended struct {
+		View `db:"testable"`
+
+		ID    Int64 `db:",key"`
+		Value String
+		Extra Int64
+	}
+	ts.Driver.Connect(&Extended)
+
+	diff, err = Plan(Extended)
+	should.NotError(err).Test(t)
+	should.Be(1)(len(diff.Changes)).Test(t)
+	should.Be(MissingColumn)(diff.Changes[0].Difference).Test(t)
+	should.NotError(diff.Err()).Test(t)
+
+	//Mismatched types cannot be synced.
+	var Mismatched struct {
+		View `db:"testable"`
+
+		ID    Int64 `db:",key"`
+		Value Int64
+	}
+	ts.Driver.Connect(&Mismatched)
+
+	diff, err = Plan(Mismatched)
+	should.NotError(err).Test(t)
+	should.Be(1)(len(diff.Conflicts())).Test(t)
+	should.Be(TypeMismatch)(diff.Conflicts()[0].Difference).Test(t)
+	should.Error(Sync(Mismatched)).Test(t)
+
+	diff, err = Plan(ts.Testable)
+	should.NotError(err).Test(t)
+	should.Be(true)(diff.Empty()).Test(t)
+
+	//Missing tables are created by Sync.
+	var Missing struct {
+		View `db:"plannable"`
+
+		ID Int64 `db:",key"`
+	}
+	ts.Driver.Connect(&Missing)
+
+	diff, err = Plan(Missing)
+	should.NotError(err).Test(t)
+	should.Be(1)(len(diff.Changes)).Test(t)
+	should.Be(MissingTable)(diff.Changes[0].Difference).Test(t)
+}
+
+func (ts *TestSuite) TestPlanTypes() {
+	defer ts.isolation()()
+
+	var t = ts.T()
+
+	//Every column type is reported by the database the way that Sync created it.
+	var Typeable struct {
+		View `db:"typeable"`
+
+		ID Int64 `db:",key,auto"`
+
+		Int32   Int32
+		Int64   Int64
+		Float32 Float32
+		Float64 Float64
+		Bool    Bool
+		Bytes   Bytes
+		String  String
+		Time    Time
+		UUID    UUID
+		Decimal Decimal
+		Fixed   Decimal `db:",precision=10,scale=2"`
+		JSON    JSON
+
+		NullInt32   NullInt32
+		NullInt64   NullInt64
+		NullFloat64 NullFloat64
+		NullBool    NullBool
+		NullString  NullString
+		NullTime    NullTime
+		NullUUID    NullUUID
+		NullDecimal NullDecimal
+	}
+	ts.Driver.Connect(&Typeable)
+
+	should.NotError(Sync(Typeable)).Test(t)
+	defer Delete(&Typeable)
+
+	diff, err := Plan(Typeable)
+	should.NotError(err).Test(t)
+	should.Be(0)(len(diff.Changes)).Test(t)
+
+	should.NotError(Sync(Typeable)).Test(t)
+
+	diff, err = Plan(Typeable)
+	should.NotError(err).Test(t)
+	should.Be(0)(len(diff.Changes)).Test(t)
+}
+
 func (ts *TestSuite) insert() {
 	var t = ts.T()
 
@@ -214,6 +348,789 @@ func (ts *TestSuite) TestPrimaryKey() {
 	).Test(t)
 }
 
+//TestInsertMany tests that the driver inserts multiple rows atomically.
+func (ts *TestSuite) TestIndex() {
+	defer ts.isolation()()
+
+	var t = ts.T()
+
+	var Indexable struct {
+		View `db:"indexable"`
+
+		ID    Int64  `db:",key"`
+		Group String `db:",index"`
+	}
+	ts.Driver.Connect(&Indexable)
+
+	should.NotError(Sync(Indexable)).Test(t)
+	defer Delete(&Indexable)
+
+	var row = Indexable
+	for i := int64(0); i < 100; i++ {
+		row.ID.Set(i)
+		row.Group.Set(fmt.Sprint(i % 10))
+		should.NotError(Insert(row)).Test(t)
+	}
+
+	should.Be(ErrDuplicateKey)(Insert(row)).Test(t)
+
+	//Deleting rows moves other rows around, the indexes must follow.
+	_, err := If(Indexable.Group.Equals("0")).Delete()
+	should.NotError(err).Test(t)
+
+	_, err = If(Indexable.ID.Equals(11)).Update(Indexable.Group.To("0"))
+	should.NotError(err).Test(t)
+
+	count, err := If(Indexable.Group.Equals("0")).Count(Indexable.ID)
+	should.NotError(err).Test(t)
+	should.Be(1)(count).Test(t)
+
+	count, err = If(Indexable.Group.Equals("1")).Count(Indexable.ID)
+	should.NotError(err).Test(t)
+	should.Be(9)(count).Test(t)
+
+	var result = Indexable
+	should.NotError(If(Indexable.ID.Equals(99)).Get(&result)).Test(t)
+	should.Be("9")(result.Group.Value()).Test(t)
+
+	should.Be(ErrNotFound)(If(Indexable.ID.Equals(50)).Get(&result)).Test(t)
+
+	row.ID.Set(50)
+	should.NotError(Insert(row)).Test(t)
+	should.Be(ErrDuplicateKey)(Insert(row)).Test(t)
+}
+
+func (ts *TestSuite) TestUnique() {
+	defer ts.isolation()()
+
+	var t = ts.T()
+
+	var Uniqueable struct {
+		View `db:"uniqueable"`
+
+		ID    Int64  `db:",key"`
+		Email String `db:",unique"`
+		Owner Int64  `db:",unique=by_owner_date"`
+		Date  String `db:",index=by_owner_date"`
+		Tag   String `db:",index"`
+	}
+	ts.Driver.Connect(&Uniqueable)
+
+	should.Be([]Index{
+		{Name: "uniqueable_Email", Columns: []string{"Email"}, Unique: true},
+		{Name: "by_owner_date", Columns: []string{"Owner", "Date"}, Unique: true},
+		{Name: "uniqueable_Tag", Columns: []string{"Tag"}},
+	})(Indexes(Uniqueable)).Test(t)
+
+	should.NotError(Sync(Uniqueable)).Test(t)
+	defer Delete(&Uniqueable)
+
+	var row = Uniqueable
+	row.ID.Set(1)
+	row.Email.Set("a@example.com")
+	row.Owner.Set(1)
+	row.Date.Set("monday")
+	row.Tag.Set("shared")
+	should.NotError(Insert(row)).Test(t)
+
+	row.ID.Set(2)
+	should.Be(ErrDuplicateKey)(Insert(row)).Test(t)
+
+	//Composite indexes only reject rows that match in every column.
+	row.Email.Set("b@example.com")
+	should.Be(ErrDuplicateKey)(Insert(row)).Test(t)
+
+	row.Date.Set("tuesday")
+	should.NotError(Insert(row)).Test(t)
+
+	//Updates and upserts must not break the indexes either.
+	_, err := If(Uniqueable.ID.Equals(2)).Update(Uniqueable.Email.To("a@example.com"))
+	should.Be(ErrDuplicateKey)(err).Test(t)
+
+	row.Email.Set("a@example.com")
+	should.Be(ErrDuplicateKey)(Upsert(row)).Test(t)
+
+	var result = Uniqueable
+	should.NotError(If(Uniqueable.ID.Equals(2)).Get(&result)).Test(t)
+	should.Be("b@example.com")(result.Email.Value()).Test(t)
+
+	count, err := If(Uniqueable.Tag.Equals("shared")).Count(Uniqueable.ID)
+	should.NotError(err).Test(t)
+	should.Be(2)(count).Test(t)
+}
+
+func (ts *TestSuite) TestTags() {
+	defer ts.isolation()()
+
+	var t = ts.T()
+
+	//Misspelt options are not silently ignored.
+	var Misspelt struct {
+		View `db:"misspelt"`
+
+		ID    Int64  `db:",key"`
+		Email String `db:",uniqe"`
+	}
+	should.Error(Connect(&Misspelt, ts.Driver)).Test(t)
+	should.Error(Sync(Misspelt)).Test(t)
+
+	var Valued struct {
+		View `db:"misspelt"`
+
+		ID Int64 `db:",key=true"`
+	}
+	should.Error(Connect(&Valued, ts.Driver)).Test(t)
+
+	//Only decimal columns have a precision and a scale.
+	var Sized struct {
+		View `db:"sized"`
+
+		ID    Int64  `db:",key"`
+		Price String `db:",precision=10,scale=2"`
+	}
+	should.Error(Connect(&Sized, ts.Driver)).Test(t)
+}
+
+func (ts *TestSuite) TestForeignKey() {
+	defer ts.isolation()()
+
+	var t = ts.T()
+
+	var Parentable struct {
+		View `db:"parentable"`
+
+		ID   Int64 `db:",key"`
+		Name String
+	}
+	var Childable struct {
+		View `db:"childable"`
+
+		ID     Int64 `db:",key"`
+		Parent Int64 `db:",references=parentable.id,ondelete=cascade"`
+	}
+	var Noteable struct {
+		View `db:"noteable"`
+
+		ID     Int64 `db:",key"`
+		Parent Int64 `db:",references=parentable.id"`
+	}
+
+	ts.Driver.Connect(&Parentable, &Childable, &Noteable)
+
+	should.Be([]ForeignKey{
+		{Column: "Parent", Table: "parentable", References: "id", OnDelete: Cascade},
+	})(ForeignKeys(Childable)).Test(t)
+	should.Be([]ForeignKey{
+		{Column: "Parent", Table: "parentable", References: "id", OnDelete: Restrict},
+	})(ForeignKeys(Noteable)).Test(t)
+
+	//Actions other than cascade and restrict are rejected, rather than treated as restrict.
+	var Settable struct {
+		View `db:"settable"`
+
+		ID     Int64 `db:",key"`
+		Parent Int64 `db:",references=parentable.id,ondelete=set null"`
+	}
+	should.Error(Connect(&Settable, ts.Driver)).Test(t)
+
+	var Unreferenced struct {
+		View `db:"unreferenced"`
+
+		ID     Int64 `db:",key"`
+		Parent Int64 `db:",ondelete=cascade"`
+	}
+	should.Error(Connect(&Unreferenced, ts.Driver)).Test(t)
+
+	//Drivers that cannot enforce foreign keys refuse to sync them.
+	var err = Sync(Parentable, Childable, Noteable)
+	if err == ErrUnsupported {
+		Delete(&Parentable)
+		return
+	}
+	should.NotError(err).Test(t)
+	defer Delete(&Parentable, &Childable, &Noteable)
+
+	var parent = Parentable
+	parent.ID.Set(1)
+	parent.Name.Set("first")
+	should.NotError(Insert(parent)).Test(t)
+	parent.ID.Set(2)
+	parent.Name.Set("second")
+	should.NotError(Insert(parent)).Test(t)
+
+	var child = Childable
+	child.ID.Set(1)
+	child.Parent.Set(3)
+	should.Be(ErrForeignKey)(Insert(child)).Test(t)
+
+	child.Parent.Set(1)
+	should.NotError(Insert(child)).Test(t)
+	child.ID.Set(2)
+	child.Parent.Set(2)
+	should.NotError(Insert(child)).Test(t)
+
+	_, err = If(Childable.ID.Equals(1)).Update(Childable.Parent.To(3))
+	should.Be(ErrForeignKey)(err).Test(t)
+
+	var note = Noteable
+	note.ID.Set(1)
+	note.Parent.Set(2)
+	should.NotError(Insert(note)).Test(t)
+
+	//Deleting a parent removes the children that cascade.
+	_, err = If(Parentable.ID.Equals(1)).Delete()
+	should.NotError(err).Test(t)
+
+	count, err := If(Childable.Parent.Equals(1)).Count(Childable.ID)
+	should.NotError(err).Test(t)
+	should.Be(0)(count).Test(t)
+
+	//A parent that is still referenced by a restricting key cannot be deleted.
+	_, err = If(Parentable.ID.Equals(2)).Delete()
+	should.Be(ErrForeignKey)(err).Test(t)
+
+	count, err = If(Childable.Parent.Equals(2)).Count(Childable.ID)
+	should.NotError(err).Test(t)
+	should.Be(1)(count).Test(t)
+
+	_, err = If(Noteable.ID.Equals(1)).Delete()
+	should.NotError(err).Test(t)
+
+	_, err = If(Parentable.ID.Equals(2)).Delete()
+	should.NotError(err).Test(t)
+
+	count, err = If(Childable.Parent.Equals(2)).Count(Childable.ID)
+	should.NotError(err).Test(t)
+	should.Be(0)(count).Test(t)
+}
+
+func (ts *TestSuite) TestNull() {
+	defer ts.isolation()()
+
+	var t = ts.T()
+
+	var Nullable struct {
+		View `db:"nullable"`
+
+		ID   Int64 `db:",key"`
+		Age  NullInt64
+		Name NullString
+	}
+	ts.Driver.Connect(&Nullable)
+
+	should.NotError(Sync(Nullable)).Test(t)
+	defer Delete(&Nullable)
+
+	var ages = []int64{30, 10}
+	var name = "Alice"
+
+	var row = Nullable
+	row.ID.Set(1)
+	row.Age.Set(&ages[0])
+	row.Name.Set(&name)
+	should.NotError(Insert(row)).Test(t)
+
+	row = Nullable
+	row.ID.Set(2)
+	should.NotError(Insert(row)).Test(t)
+
+	row = Nullable
+	row.ID.Set(3)
+	row.Age.Set(&ages[1])
+	should.NotError(Insert(row)).Test(t)
+
+	var result = Nullable
+	should.NotError(If(Nullable.ID.Equals(2)).Get(&result)).Test(t)
+	should.Be((*int64)(nil))(result.Age.Value()).Test(t)
+
+	encoded, err := json.Marshal(result.Age)
+	should.NotError(err).Test(t)
+	should.Be("null")(string(encoded)).Test(t)
+
+	should.NotError(If(Nullable.ID.Equals(1)).Get(&result)).Test(t)
+	should.Be(int64(30))(*result.Age.Value()).Test(t)
+	should.Be("Alice")(result.Name.String()).Test(t)
+
+	//Stored values do not point to the values of inserted rows or of results.
+	ages[0] = 31
+	*result.Age.Value() = 32
+	should.NotError(If(Nullable.ID.Equals(1)).Get(&result)).Test(t)
+	should.Be(int64(30))(*result.Age.Value()).Test(t)
+	ages[0] = 30
+
+	//NULL values only match IsNull, never comparisons.
+	for _, expectation := range []struct {
+		Condition
+		count int
+	}{
+		{Nullable.Age.IsNull(), 1},
+		{Nullable.Age.NotNull(), 2},
+		{Nullable.Age.Equals(nil), 1},
+		{Nullable.Age.NotEquals(nil), 2},
+		{Nullable.Age.NotEquals(&ages[0]), 1},
+		{Nullable.Age.GreaterThan(&ages[1]), 1},
+		{Nullable.Age.In(&ages[0], &ages[1]), 2},
+		{Nullable.Age.NotIn(&ages[1]), 1},
+		{Nullable.Name.In(&name), 1},
+		{Nullable.Name.IsNull(), 2},
+	} {
+		count, err := If(expectation.Condition).Count(Nullable.ID)
+		should.NotError(err).Test(t)
+		should.Be(expectation.count)(count).Test(t)
+	}
+
+	//Aggregates leave out NULL values.
+	var sum = Nullable
+	should.NotError(If(Nullable.ID.NotEquals(0)).Sum(&sum.Age)).Test(t)
+	should.Be(int64(40))(*sum.Age.Value()).Test(t)
+
+	avg, err := If(Nullable.ID.NotEquals(0)).Average(Nullable.Age)
+	should.NotError(err).Test(t)
+	should.Be(float64(20))(avg).Test(t)
+
+	should.NotError(If(Nullable.ID.NotEquals(0)).Min(&sum.Age)).Test(t)
+	should.Be(int64(10))(*sum.Age.Value()).Test(t)
+
+	*sum.Age.Value() = 11
+	should.NotError(If(Nullable.ID.NotEquals(0)).Min(&sum.Age)).Test(t)
+	should.Be(int64(10))(*sum.Age.Value()).Test(t)
+
+	should.NotError(If(Nullable.ID.Equals(2)).Sum(&sum.Age)).Test(t)
+	should.Be((*int64)(nil))(sum.Age.Value()).Test(t)
+
+	//Updated values do not point to the value that they were updated to.
+	var age int64 = 40
+	_, err = If(Nullable.ID.Equals(3)).Update(Nullable.Age.To(&age))
+	should.NotError(err).Test(t)
+	age = 41
+	should.NotError(If(Nullable.ID.Equals(3)).Get(&result)).Test(t)
+	should.Be(int64(40))(*result.Age.Value()).Test(t)
+
+	_, err = If(Nullable.ID.Equals(1)).Update(Nullable.Age.To(nil))
+	should.NotError(err).Test(t)
+
+	count, err := If(Nullable.Age.IsNull()).Count(Nullable.ID)
+	should.NotError(err).Test(t)
+	should.Be(2)(count).Test(t)
+}
+
+func (ts *TestSuite) TestDecimal() {
+	defer ts.isolation()()
+
+	var t = ts.T()
+
+	var Priceable struct {
+		View `db:"priceable"`
+
+		ID       Int64       `db:",key"`
+		Price    Decimal     `db:",precision=5,scale=2"`
+		Discount NullDecimal `db:",precision=3,scale=1"`
+	}
+	ts.Driver.Connect(&Priceable)
+
+	should.NotError(Sync(Priceable)).Test(t)
+	defer Delete(&Priceable)
+
+	//Decimals are stored rounded to the scale of their column.
+	var discount = Dec("0.25")
+
+	var row = Priceable
+	row.ID.Set(1)
+	row.Price.Set("12.345")
+	row.Discount.Set(&discount)
+	should.NotError(Insert(row)).Test(t)
+
+	var result = Priceable
+	should.NotError(If(Priceable.ID.Equals(1)).Get(&result)).Test(t)
+	should.Be(Dec("12.35"))(result.Price.Value().canonical()).Test(t)
+	should.Be(Dec("0.3"))(result.Discount.Value().canonical()).Test(t)
+
+	_, err := If(Priceable.ID.Equals(1)).Update(Priceable.Price.To("-1.005"))
+	should.NotError(err).Test(t)
+
+	should.NotError(If(Priceable.ID.Equals(1)).Get(&result)).Test(t)
+	should.Be(Dec("-1.01"))(result.Price.Value().canonical()).Test(t)
+
+	//Decimals with more digits than the precision allows are rejected.
+	row.ID.Set(2)
+	row.Price.Set("1234.5")
+	should.Error(Insert(row)).Test(t)
+
+	_, err = If(Priceable.ID.Equals(1)).Update(Priceable.Price.To("999.995"))
+	should.Error(err).Test(t)
+
+	should.NotError(If(Priceable.ID.Equals(1)).Get(&result)).Test(t)
+	should.Be(Dec("-1.01"))(result.Price.Value().canonical()).Test(t)
+
+	count, err := If(Priceable.ID.Equals(2)).Count(Priceable.ID)
+	should.NotError(err).Test(t)
+	should.Be(0)(count).Test(t)
+
+	//Sums and averages of decimals are exact.
+	var discounts = []Dec{"0.1", "0.2"}
+	for i, price := range []Dec{"0.10", "0.20"} {
+		row = Priceable
+		row.ID.Set(int64(i + 3))
+		row.Price.Set(price)
+		row.Discount.Set(&discounts[i])
+		should.NotError(Insert(row)).Test(t)
+	}
+
+	var sum = Priceable
+	should.NotError(If(Priceable.ID.In(3, 4)).Sum(&sum.Price)).Test(t)
+	should.Be(Dec("0.3"))(sum.Price.Value().canonical()).Test(t)
+
+	should.NotError(If(Priceable.ID.In(3, 4)).Sum(&sum.Discount)).Test(t)
+	should.Be(Dec("0.3"))(sum.Discount.Value().canonical()).Test(t)
+
+	avg, err := If(Priceable.ID.In(3, 4)).Average(Priceable.Price)
+	should.NotError(err).Test(t)
+	should.Be(0.15)(avg).Test(t)
+
+	//Decimals are encoded as JSON strings that decode to the same values.
+	encoded, err := json.Marshal(If(Priceable.ID.In(1, 3)))
+	should.NotError(err).Test(t)
+
+	var decoded []struct {
+		ID       int64
+		Price    Dec
+		Discount *Dec
+	}
+	should.NotError(json.Unmarshal(encoded, &decoded)).Test(t)
+	should.Be(2)(len(decoded)).Test(t)
+
+	for _, result := range decoded {
+		switch result.ID {
+		case 1:
+			should.Be(Dec("-1.01"))(result.Price.canonical()).Test(t)
+			should.Be(Dec("0.3"))(result.Discount.canonical()).Test(t)
+		case 3:
+			should.Be(Dec("0.1"))(result.Price.canonical()).Test(t)
+			should.Be(Dec("0.1"))(result.Discount.canonical()).Test(t)
+		default:
+			t.Fatalf("unexpected id %v", result.ID)
+		}
+	}
+}
+
+func (ts *TestSuite) TestJSON() {
+	defer ts.isolation()()
+
+	var t = ts.T()
+
+	type Settings struct {
+		Theme string   `json:"theme"`
+		Size  int      `json:"size"`
+		Tags  []string `json:"tags"`
+	}
+
+	var Configurable struct {
+		View `db:"configurable"`
+
+		ID       Int64 `db:",key"`
+		Settings JSON
+	}
+	ts.Driver.Connect(&Configurable)
+
+	should.NotError(Sync(Configurable)).Test(t)
+	defer Delete(&Configurable)
+
+	for i, settings := range []Settings{
+		{"dark", 12, []string{"beta"}},
+		{"light", 14, nil},
+		{"dark", 16, []string{"beta", "admin"}},
+	} {
+		var row = Configurable
+		row.ID.Set(int64(i + 1))
+		should.NotError(row.Settings.Encode(settings)).Test(t)
+		should.NotError(Insert(row)).Test(t)
+	}
+
+	count, err := If(Configurable.Settings.Path("theme").Equals("dark")).Count(Configurable.ID)
+	should.NotError(err).Test(t)
+	should.Be(2)(count).Test(t)
+
+	count, err = If(Configurable.Settings.Path("size").GreaterThan(12)).Count(Configurable.ID)
+	should.NotError(err).Test(t)
+	should.Be(2)(count).Test(t)
+
+	count, err = If(Configurable.Settings.Path("tags", "1").Equals("admin")).Count(Configurable.ID)
+	should.NotError(err).Test(t)
+	should.Be(1)(count).Test(t)
+
+	//Conditions on missing values are false.
+	count, err = If(Configurable.Settings.Path("missing").NotEquals("dark")).Count(Configurable.ID)
+	should.NotError(err).Test(t)
+	should.Be(0)(count).Test(t)
+
+	count, err = If(Configurable.Settings.Path("tags").HasKey("beta")).Count(Configurable.ID)
+	should.NotError(err).Test(t)
+	should.Be(2)(count).Test(t)
+
+	count, err = If(Configurable.Settings.HasKey("tags")).Count(Configurable.ID)
+	should.NotError(err).Test(t)
+	should.Be(3)(count).Test(t)
+
+	count, err = If(Configurable.Settings.Contains(map[string]interface{}{
+		"theme": "dark",
+		"tags":  []string{"admin"},
+	})).Count(Configurable.ID)
+	should.NotError(err).Test(t)
+	should.Be(1)(count).Test(t)
+
+	var result = Configurable
+	should.NotError(If(Configurable.ID.Equals(3)).Get(&result)).Test(t)
+
+	var settings Settings
+	should.NotError(result.Settings.Decode(&settings)).Test(t)
+	should.Be(Settings{"dark", 16, []string{"beta", "admin"}})(settings).Test(t)
+
+	//Documents are embedded, not quoted.
+	encoded, err := json.Marshal(result.Settings)
+	should.NotError(err).Test(t)
+
+	settings = Settings{}
+	should.NotError(json.Unmarshal(encoded, &settings)).Test(t)
+	should.Be(Settings{"dark", 16, []string{"beta", "admin"}})(settings).Test(t)
+
+	//Documents compare by value.
+	count, err = If(Configurable.Settings.Equals(`{ "tags": ["beta", "admin"], "size": 16, "theme": "dark" }`)).Count(Configurable.ID)
+	should.NotError(err).Test(t)
+	should.Be(1)(count).Test(t)
+}
+
+func (ts *TestSuite) TestInsertMany() {
+	defer ts.isolation()()
+
+	var t = ts.T()
+
+	var rows = make([]Row, 10)
+	for i := range rows {
+		var test = ts.Testable
+		test.ID.Set(int64(i + 1))
+		test.Value.Set("Many")
+		rows[i] = test
+	}
+
+	should.NotError(Insert(rows[0], rows[1:]...)).Test(t)
+
+	count, err := If(ts.Testable.Value.Equals("Many")).Count(ts.Testable.ID)
+	should.NotError(err).Test(t)
+	should.Be(10)(count).Test(t)
+
+	//The last row is a duplicate, so none of the rows should be inserted.
+	var first, duplicate = ts.Testable, ts.Testable
+	first.ID.Set(11)
+	first.Value.Set("Many")
+	duplicate.ID.Set(1)
+	duplicate.Value.Set("Many")
+
+	should.Be(ErrDuplicateKey)(Insert(first, duplicate)).Test(t)
+
+	count, err = If(ts.Testable.Value.Equals("Many")).Count(ts.Testable.ID)
+	should.NotError(err).Test(t)
+	should.Be(10)(count).Test(t)
+}
+
+//TestInsertBulk tests that the driver can insert enough rows at once for them to be inserted in bulk.
+func (ts *TestSuite) TestInsertBulk() {
+	defer ts.isolation()()
+
+	var t = ts.T()
+
+	var Bulky struct {
+		View `db:"Bulky"`
+
+		ID    Int64 `db:",key"`
+		Order Int64
+		Value String
+	}
+	ts.Driver.Connect(&Bulky)
+
+	should.NotError(Sync(Bulky)).Test(t)
+	defer Delete(&Bulky)
+
+	var rows = make([]Row, 1500)
+	for i := range rows {
+		var row = Bulky
+		row.ID.Set(int64(i + 1))
+		row.Order.Set(2)
+		row.Value.Set("Bulk")
+		rows[i] = row
+	}
+
+	should.NotError(Insert(rows[0], rows[1:]...)).Test(t)
+
+	count, err := If(Bulky.Value.Equals("Bulk")).Count(Bulky.ID)
+	should.NotError(err).Test(t)
+	should.Be(1500)(count).Test(t)
+
+	var sum = Bulky
+	should.NotError(If(Bulky.ID.NotEquals(0)).Sum(&sum.Order)).Test(t)
+	should.Be(int64(3000))(sum.Order.Value()).Test(t)
+}
+
+//TestUpsert tests that the driver will update rows that already exist when upserting.
+func (ts *TestSuite) TestUpsert() {
+	defer ts.isolation()()
+
+	var t = ts.T()
+
+	ts.insert()
+
+	var test = ts.Testable
+	test.ID.Set(1)
+	test.Value.Set("Upserted")
+	should.NotError(Upsert(test)).Test(t)
+
+	test.ID.Set(2)
+	test.Value.Set("Inserted")
+	should.NotError(Upsert(test)).Test(t)
+
+	count, err := If(test.Value.NotEquals("")).Count(test.ID)
+	should.NotError(err).Test(t)
+	should.Be(2)(count).Test(t)
+
+	should.NotError(If(test.ID.Equals(1)).Get(&test)).Test(t)
+	should.Be("Upserted")(test.Value.Value()).Test(t)
+
+	//Only the key is overwritten, so nothing changes.
+	test.Value.Set("Ignored")
+	should.NotError(Overwrite(test.ID).Upsert(test)).Test(t)
+
+	should.NotError(If(test.ID.Equals(1)).Get(&test)).Test(t)
+	should.Be("Upserted")(test.Value.Value()).Test(t)
+
+	test.Value.Set("Overwritten")
+	should.NotError(Overwrite(test.Value).Upsert(test)).Test(t)
+
+	should.NotError(If(test.ID.Equals(1)).Get(&test)).Test(t)
+	should.Be("Overwritten")(test.Value.Value()).Test(t)
+}
+
+//TestUpsertUnique tests that upserts only overwrite the row with the same key,
+//a row that only has the same value in a unique index is a duplicate.
+func (ts *TestSuite) TestUpsertUnique() {
+	defer ts.isolation()()
+
+	var t = ts.T()
+
+	var Upsertable struct {
+		View `db:"upsertable"`
+
+		ID    Int64  `db:",key"`
+		Name  String `db:",unique"`
+		Value String
+	}
+	ts.Driver.Connect(&Upsertable)
+
+	should.NotError(Sync(Upsertable)).Test(t)
+	defer Delete(&Upsertable)
+
+	var row = Upsertable
+	row.ID.Set(1)
+	row.Name.Set("first")
+	row.Value.Set("Hello")
+	should.NotError(Insert(row)).Test(t)
+
+	//Another key with the same name.
+	row.ID.Set(2)
+	row.Value.Set("World")
+	should.Be(ErrDuplicateKey)(Upsert(row)).Test(t)
+
+	count, err := If(Upsertable.Name.Equals("first")).Count(Upsertable.ID)
+	should.NotError(err).Test(t)
+	should.Be(1)(count).Test(t)
+
+	should.NotError(If(Upsertable.ID.Equals(1)).Get(&row)).Test(t)
+	should.Be("Hello")(row.Value.Value()).Test(t)
+
+	//The same key with the same name.
+	row.ID.Set(1)
+	row.Value.Set("World")
+	should.NotError(Upsert(row)).Test(t)
+
+	should.NotError(If(Upsertable.ID.Equals(1)).Get(&row)).Test(t)
+	should.Be("World")(row.Value.Value()).Test(t)
+
+	//Either all of the rows are upserted or none of them are.
+	var changed, duplicate = Upsertable, Upsertable
+	changed.ID.Set(1)
+	changed.Name.Set("first")
+	changed.Value.Set("Changed")
+	duplicate.ID.Set(2)
+	duplicate.Name.Set("first")
+	duplicate.Value.Set("Duplicate")
+	should.Be(ErrDuplicateKey)(Upsert(changed, duplicate)).Test(t)
+
+	should.NotError(If(Upsertable.ID.Equals(1)).Get(&row)).Test(t)
+	should.Be("World")(row.Value.Value()).Test(t)
+
+	//Columns of another table cannot be overwritten.
+	should.Error(Overwrite(Upsertable.Name).Upsert(ts.Testable)).Test(t)
+}
+
+//TestAuto tests that the driver generates the values of auto columns that are inserted as zero.
+func (ts *TestSuite) TestAuto() {
+	defer ts.isolation()()
+
+	var t = ts.T()
+
+	var Autoable struct {
+		View `db:"autoable"`
+
+		ID   Int64 `db:",key,auto"`
+		Name String
+	}
+	ts.Driver.Connect(&Autoable)
+
+	should.NotError(Sync(Autoable)).Test(t)
+	defer Delete(&Autoable)
+
+	var row = Autoable
+	row.Name.Set("first")
+	should.NotError(Insert(&row)).Test(t)
+	should.Be(int64(1))(row.ID.Value()).Test(t)
+
+	//Rows that are not passed by pointer still get a key.
+	var second = Autoable
+	second.Name.Set("second")
+	should.NotError(Insert(second)).Test(t)
+	should.Be(int64(0))(second.ID.Value()).Test(t)
+
+	//Explicit keys are kept and advance the counter.
+	row.ID.Set(10)
+	row.Name.Set("tenth")
+	should.NotError(Insert(&row)).Test(t)
+
+	var a, b = Autoable, Autoable
+	should.NotError(Insert(&a, &b)).Test(t)
+	should.Be(int64(11))(a.ID.Value()).Test(t)
+	should.Be(int64(12))(b.ID.Value()).Test(t)
+
+	//Explicit keys in bulk inserts advance the counter too.
+	var c, d = Autoable, Autoable
+	c.ID.Set(20)
+	should.NotError(Insert(&c, &d)).Test(t)
+	should.Be(int64(21))(d.ID.Value()).Test(t)
+
+	var e = Autoable
+	should.NotError(Insert(&e)).Test(t)
+	should.Be(int64(22))(e.ID.Value()).Test(t)
+
+	var result = Autoable
+	should.NotError(If(Autoable.ID.Equals(2)).Get(&result)).Test(t)
+	should.Be("second")(result.Name.Value()).Test(t)
+
+	//Only integer columns can be generated.
+	var Invalid struct {
+		View `db:"autoable"`
+
+		ID String `db:",key,auto"`
+	}
+	should.Error(Connect(&Invalid, ts.Driver)).Test(t)
+}
+
 //TestLink tests that the driver can link two tables together for filtering.
 func (ts *TestSuite) TestLink() {
 	defer ts.isolation()()
@@ -243,3 +1160,92 @@ func (ts *TestSuite) TestLink() {
 
 	should.Be("LinkedValue")(result.Value.Value()).Test(t)
 }
+
+//TestTransaction tests that the driver can commit and rollback transactions.
+func (ts *TestSuite) TestTransaction() {
+	defer ts.isolation()()
+
+	var t = ts.T()
+
+	tx, err := ts.Driver.Begin()
+	should.NotError(err).Test(t)
+
+	//Connect a viewer to the transaction.
+	var Testable = ts.Testable
+	tx.Connect(&Testable)
+
+	var test = Testable
+	test.ID.Set(1)
+	test.Value.Set("RolledBack")
+	should.NotError(Insert(test)).Test(t)
+
+	//Rows should be visible inside of the transaction.
+	var result = Testable
+	should.NotError(
+		If(Testable.Value.Equals("RolledBack")).Get(&result),
+	).Test(t)
+
+	should.NotError(tx.Rollback()).Test(t)
+	should.Error(tx.Commit()).Test(t)
+
+	//Rolled back rows should not be visible.
+	result = ts.Testable
+	should.Be(ErrNotFound)(
+		If(ts.Testable.Value.Equals("RolledBack")).Get(&result),
+	).Test(t)
+
+	tx, err = ts.Driver.Begin()
+	should.NotError(err).Test(t)
+	defer tx.Close()
+
+	Testable = ts.Testable
+	tx.Connect(&Testable)
+
+	test = Testable
+	test.ID.Set(2)
+	test.Value.Set("Committed")
+	should.NotError(Insert(test)).Test(t)
+
+	//Rows should not be visible outside of the transaction until it is committed.
+	result = ts.Testable
+	should.Be(ErrNotFound)(
+		If(ts.Testable.Value.Equals("Committed")).Get(&result),
+	).Test(t)
+
+	should.NotError(tx.Commit()).Test(t)
+
+	result = ts.Testable
+	should.NotError(
+		If(ts.Testable.Value.Equals("Committed")).Get(&result),
+	).Test(t)
+
+	should.Be(int64(2))(result.ID.Value()).Test(t)
+}
+
+//TestContext tests that the driver honours cancelled contexts.
+func (ts *TestSuite) TestContext() {
+	defer ts.isolation()()
+
+	var t = ts.T()
+
+	var test = ts.dummyRows()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var result = ts.Testable
+	should.Error(
+		If(test.Value.Equals("Hello")).WithContext(ctx).Get(&result),
+	).Test(t)
+
+	_, err := If(test.Value.Equals("World")).WithContext(ctx).Count(test.Value)
+	should.Error(err).Test(t)
+
+	test.ID.Set(4)
+	should.Error(InsertContext(ctx, test)).Test(t)
+
+	//The same filter without the cancelled context should succeed.
+	should.NotError(
+		If(test.Value.Equals("Hello")).Get(&result),
+	).Test(t)
+}
@@ -0,0 +1,22 @@
+package db
+
+import "context"
+
+//Tx is a database transaction.
+//It is a Driver so that viewers can be connected to it, their operations are then performed inside of the transaction.
+//Closing a Tx rolls it back, unless it has already been committed.
+type Tx interface {
+	Driver
+
+	//Commit commits the transaction.
+	Commit() error
+
+	//Rollback aborts the transaction, discarding any changes.
+	Rollback() error
+}
+
+//Beginner is implemented by drivers that can begin a transaction with a context.
+type Beginner interface {
+	//BeginContext starts a transaction with the given context.
+	BeginContext(ctx context.Context) (Tx, error)
+}
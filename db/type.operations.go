@@ -13,21 +13,18 @@ const (
 	OpHasPrefix
 	OpLessThan
 	OpDivisibleBy
+	OpGreaterThan
+	OpLessOrEqual
+	OpGreaterOrEqual
+	OpBetween
+	OpIn
+	OpNotIn
+	OpIsNull
+	OpNotNull
+	OpHasKey
+	OpContainsJSON
 )
 
-//LessThan returns a condition that is true if i is less then val.
-func (i Int64) LessThan(val int64) Condition {
-	return Condition{
-		Table:  i.table,
-		View:   i.view,
-		driver: i.driver,
-
-		Column:   i.column,
-		Operator: OpLessThan,
-		Value:    val,
-	}
-}
-
 //DivisibleBy returns a condition that is true if i is divisible by val.
 func (i Int64) DivisibleBy(val int64) Condition {
 	return Condition{
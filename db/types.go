@@ -20,19 +20,27 @@ import (
 
 // types.go2:13
 type (
-	Int8  struct{ instantiate୦୦Type୦int8 }
-	Int16 struct{ instantiate୦୦Type୦int16 }
-	Int32 struct{ instantiate୦୦Type୦int32 }
-	Int64 struct{ instantiate୦୦Type୦int64 }
+	Int8 struct {
+		instantiate୦୦Ordered୦int8
+	}
+	Int16 struct {
+		instantiate୦୦Ordered୦int16
+	}
+	Int32 struct {
+		instantiate୦୦Ordered୦int32
+	}
+	Int64 struct {
+		instantiate୦୦Ordered୦int64
+	}
 
 	Float32 struct {
 		// types.go2:19
-		instantiate୦୦Type୦float64
+		instantiate୦୦Ordered୦float64
 		// types.go2:19
 	}
 	Float64 struct {
 		// types.go2:20
-		instantiate୦୦Type୦float64
+		instantiate୦୦Ordered୦float64
 		// types.go2:20
 	}
 
@@ -44,36 +52,112 @@ type (
 		instantiate୦୦Type୦୮6୮7byte
 		// types.go2:25
 	}
-	String struct{ instantiate୦୦Type୦string }
+	String struct {
+		instantiate୦୦Ordered୦string
+	}
 
 	Time struct {
 		// types.go2:28
-		instantiate୦୦Type୦time୮aTime
+		instantiate୦୦Ordered୦time୮aTime
 		// types.go2:28
 	}
 
 	UUID struct {
 		// types.go2:30
-		instantiate୦୦Type୦db୮auid
+		instantiate୦୦Ordered୦db୮auid
 		// types.go2:30
 	}
+
+	Decimal struct {
+		// types.go2:32
+		instantiate୦୦Ordered୦db୮aDec
+		// types.go2:32
+	}
+
+	JSON struct {
+		// types.go2:34
+		instantiate୦୦Type୦db୮aDocument
+		// types.go2:34
+	}
+
+	//Nullable column types, their value is nil when NULL.
+	NullInt8 struct {
+		// types.go2:37
+		instantiate୦୦Ordered୦୮1int8
+		// types.go2:37
+	}
+	NullInt16 struct {
+		// types.go2:38
+		instantiate୦୦Ordered୦୮1int16
+		// types.go2:38
+	}
+	NullInt32 struct {
+		// types.go2:39
+		instantiate୦୦Ordered୦୮1int32
+		// types.go2:39
+	}
+	NullInt64 struct {
+		// types.go2:40
+		instantiate୦୦Ordered୦୮1int64
+		// types.go2:40
+	}
+
+	NullFloat32 struct {
+		// types.go2:42
+		instantiate୦୦Ordered୦୮1float64
+		// types.go2:42
+	}
+	NullFloat64 struct {
+		// types.go2:43
+		instantiate୦୦Ordered୦୮1float64
+		// types.go2:43
+	}
+
+	NullBool struct {
+		// types.go2:45
+		instantiate୦୦Type୦୮1bool
+		// types.go2:45
+	}
+	NullString struct {
+		// types.go2:46
+		instantiate୦୦Ordered୦୮1string
+		// types.go2:46
+	}
+
+	NullTime struct {
+		// types.go2:48
+		instantiate୦୦Ordered୦୮1time୮aTime
+		// types.go2:48
+	}
+
+	NullUUID struct {
+		// types.go2:50
+		instantiate୦୦Ordered୦୮1db୮auid
+		// types.go2:50
+	}
+
+	NullDecimal struct {
+		// types.go2:52
+		instantiate୦୦Ordered୦୮1db୮aDec
+		// types.go2:52
+	}
 )
 
-// types.go2:254
-// types.go2:339
+// types.go2:414
+// types.go2:513
 type instantiate୦୦Type୦int8 struct {
-	// types.go2:34
+	// types.go2:56
 	driver        Driver
 	table, column string
 	view          Table
 
 	offset uintptr
 
-	key bool
+	tag tag
 
 	value int8
 
-	// types.go2:43
+	// types.go2:65
 	slice []int8
 
 	master *int8
@@ -104,11 +188,43 @@ func (t *instantiate୦୦Type୦int8) Master() bool {
 }
 
 func (t instantiate୦୦Type୦int8) Key() bool {
-	return t.key
+	return t.tag.key
+}
+
+func (t instantiate୦୦Type୦int8) Indexed() bool {
+	return t.tag.index
+}
+
+func (t instantiate୦୦Type୦int8) Auto() bool {
+	return t.tag.auto
+}
+
+func (t instantiate୦୦Type୦int8) Unique() bool {
+	return t.tag.unique
+}
+
+func (t instantiate୦୦Type୦int8) IndexName() string {
+	return t.tag.name
+}
+
+func (t instantiate୦୦Type୦int8) References() string {
+	return t.tag.references
+}
+
+func (t instantiate୦୦Type୦int8) OnDelete() string {
+	return t.tag.ondelete
+}
+
+func (t instantiate୦୦Type୦int8) Precision() int {
+	return t.tag.precision
+}
+
+func (t instantiate୦୦Type୦int8) Scale() int {
+	return t.tag.scale
 }
 
 func (t instantiate୦୦Type୦int8) String() string {
-	return fmt.Sprint(t.value)
+	return fmt.Sprint(deref(t.value))
 }
 
 func (t instantiate୦୦Type୦int8) Increasing() Sorter {
@@ -133,7 +249,7 @@ func (t instantiate୦୦Type୦int8) Value() int8 {
 
 func (t instantiate୦୦Type୦int8) Equals(val int8,
 
-// types.go2:100
+// types.go2:154
 ) Condition {
 	return Condition{
 		Table:    t.table,
@@ -147,7 +263,7 @@ func (t instantiate୦୦Type୦int8) Equals(val int8,
 
 func (t instantiate୦୦Type୦int8) NotEquals(val int8,
 
-// types.go2:111
+// types.go2:165
 ) Condition {
 	return Condition{
 		Table:    t.table,
@@ -159,6 +275,54 @@ func (t instantiate୦୦Type୦int8) NotEquals(val int8,
 	}
 }
 
+func (t instantiate୦୦Type୦int8) In(values ...int8,
+
+// types.go2:176
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦int8) NotIn(values ...int8,
+
+// types.go2:187
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦int8) IsNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIsNull,
+	}
+}
+
+func (t instantiate୦୦Type୦int8) NotNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotNull,
+	}
+}
+
 func (t instantiate୦୦Type୦int8) MarshalJSON() ([]byte, error) {
 	return json.Marshal(t.value)
 }
@@ -200,7 +364,7 @@ func (t *instantiate୦୦Type୦int8) Index(index int) bool {
 func (t *instantiate୦୦Type୦int8) setprivate(
 	table, column string,
 	offset uintptr,
-	key bool,
+	tag tag,
 	driver Driver,
 	view Table,
 ) {
@@ -208,21 +372,21 @@ func (t *instantiate୦୦Type୦int8) setprivate(
 	t.table = table
 	t.offset = offset
 	t.column = column
-	t.key = key
+	t.tag = tag
 	t.driver = driver
 	t.view = view
 }
 
 func (t *instantiate୦୦Type୦int8) Set(val int8,
 
-// types.go2:176
+// types.go2:272
 ) {
 	t.value = val
 }
 
 func (t instantiate୦୦Type୦int8) To(val int8,
 
-// types.go2:180
+// types.go2:276
 ) Update {
 	return Update{
 		Table:  t.table,
@@ -240,10 +404,10 @@ func (t instantiate୦୦Type୦int8) On(other struct{ instantiate୦୦Type୦i
 	}
 }
 
-// types.go2:198
+// types.go2:294
 func (t instantiate୦୦Type୦int8) Test(ctx *testing.T) {
 	var Testable struct {
-		View `db:"testable"`
+		View `db:"typeable"`
 
 		Value instantiate୦୦Type୦int8
 	}
@@ -256,7 +420,7 @@ func (t instantiate୦୦Type୦int8) Test(ctx *testing.T) {
 
 	var zero int8
 
-	// types.go2:215
+	// types.go2:311
 	var test = Testable
 	test.Value.Set(zero)
 
@@ -264,7 +428,7 @@ func (t instantiate୦୦Type୦int8) Test(ctx *testing.T) {
 		Insert(test),
 	).Test(ctx)
 
-	// types.go2:223
+	// types.go2:319
 	var result = Testable
 
 	shouldNotError(
@@ -273,33 +437,117 @@ func (t instantiate୦୦Type୦int8) Test(ctx *testing.T) {
 
 	shouldBe(zero)(result.Value.Value()).Test(ctx)
 
-	// types.go2:232
+	// types.go2:328
 	shouldNotError(
 		If(Testable.Value.Equals(zero)).Update(
 			result.Value.To(zero),
 		),
 	).Test(ctx)
 
-	// types.go2:239
+	// types.go2:335
 	shouldNotError(
 		Delete(&Testable),
 	).Test(ctx)
 }
 
-// types.go2:242
+// types.go2:341
+type instantiate୦୦Ordered୦int8 struct {
+	// types.go2:342
+	instantiate୦୦Type୦int8
+}
+
+func (t instantiate୦୦Ordered୦int8) LessThan(val int8,
+
+// types.go2:345
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpLessThan,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦int8) GreaterThan(val int8,
+
+// types.go2:356
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpGreaterThan,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦int8) LessOrEqual(val int8,
+
+// types.go2:367
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpLessOrEqual,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦int8) GreaterOrEqual(val int8,
+
+// types.go2:378
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpGreaterOrEqual,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦int8) Between(min, max int8,
+
+// types.go2:389
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpBetween,
+		Value:    []int8{min, max},
+	}
+}
+
+func (t instantiate୦୦Ordered୦int8) On(other struct {
+	// types.go2:400
+	instantiate୦୦Ordered୦int8
+	// types.go2:400
+}) Linker {
+	return t.instantiate୦୦Type୦int8.On(struct{ instantiate୦୦Type୦int8 }{other.instantiate୦୦Type୦int8})
+}
+
+// types.go2:338
 type instantiate୦୦Type୦int16 struct {
-	// types.go2:34
+	// types.go2:56
 	driver        Driver
 	table, column string
 	view          Table
 
 	offset uintptr
 
-	key bool
+	tag tag
 
 	value int16
 
-	// types.go2:43
+	// types.go2:65
 	slice []int16
 
 	master *int16
@@ -330,11 +578,43 @@ func (t *instantiate୦୦Type୦int16) Master() bool {
 }
 
 func (t instantiate୦୦Type୦int16) Key() bool {
-	return t.key
+	return t.tag.key
+}
+
+func (t instantiate୦୦Type୦int16) Indexed() bool {
+	return t.tag.index
+}
+
+func (t instantiate୦୦Type୦int16) Auto() bool {
+	return t.tag.auto
+}
+
+func (t instantiate୦୦Type୦int16) Unique() bool {
+	return t.tag.unique
+}
+
+func (t instantiate୦୦Type୦int16) IndexName() string {
+	return t.tag.name
+}
+
+func (t instantiate୦୦Type୦int16) References() string {
+	return t.tag.references
+}
+
+func (t instantiate୦୦Type୦int16) OnDelete() string {
+	return t.tag.ondelete
+}
+
+func (t instantiate୦୦Type୦int16) Precision() int {
+	return t.tag.precision
+}
+
+func (t instantiate୦୦Type୦int16) Scale() int {
+	return t.tag.scale
 }
 
 func (t instantiate୦୦Type୦int16) String() string {
-	return fmt.Sprint(t.value)
+	return fmt.Sprint(deref(t.value))
 }
 
 func (t instantiate୦୦Type୦int16) Increasing() Sorter {
@@ -359,7 +639,7 @@ func (t instantiate୦୦Type୦int16) Value() int16 {
 
 func (t instantiate୦୦Type୦int16) Equals(val int16,
 
-// types.go2:100
+// types.go2:154
 ) Condition {
 	return Condition{
 		Table:    t.table,
@@ -373,7 +653,7 @@ func (t instantiate୦୦Type୦int16) Equals(val int16,
 
 func (t instantiate୦୦Type୦int16) NotEquals(val int16,
 
-// types.go2:111
+// types.go2:165
 ) Condition {
 	return Condition{
 		Table:    t.table,
@@ -385,6 +665,54 @@ func (t instantiate୦୦Type୦int16) NotEquals(val int16,
 	}
 }
 
+func (t instantiate୦୦Type୦int16) In(values ...int16,
+
+// types.go2:176
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦int16) NotIn(values ...int16,
+
+// types.go2:187
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦int16) IsNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIsNull,
+	}
+}
+
+func (t instantiate୦୦Type୦int16) NotNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotNull,
+	}
+}
+
 func (t instantiate୦୦Type୦int16) MarshalJSON() ([]byte, error) {
 	return json.Marshal(t.value)
 }
@@ -426,7 +754,7 @@ func (t *instantiate୦୦Type୦int16) Index(index int) bool {
 func (t *instantiate୦୦Type୦int16) setprivate(
 	table, column string,
 	offset uintptr,
-	key bool,
+	tag tag,
 	driver Driver,
 	view Table,
 ) {
@@ -434,21 +762,21 @@ func (t *instantiate୦୦Type୦int16) setprivate(
 	t.table = table
 	t.offset = offset
 	t.column = column
-	t.key = key
+	t.tag = tag
 	t.driver = driver
 	t.view = view
 }
 
 func (t *instantiate୦୦Type୦int16) Set(val int16,
 
-// types.go2:176
+// types.go2:272
 ) {
 	t.value = val
 }
 
 func (t instantiate୦୦Type୦int16) To(val int16,
 
-// types.go2:180
+// types.go2:276
 ) Update {
 	return Update{
 		Table:  t.table,
@@ -466,10 +794,10 @@ func (t instantiate୦୦Type୦int16) On(other struct{ instantiate୦୦Type୦
 	}
 }
 
-// types.go2:198
+// types.go2:294
 func (t instantiate୦୦Type୦int16) Test(ctx *testing.T) {
 	var Testable struct {
-		View `db:"testable"`
+		View `db:"typeable"`
 
 		Value instantiate୦୦Type୦int16
 	}
@@ -482,7 +810,7 @@ func (t instantiate୦୦Type୦int16) Test(ctx *testing.T) {
 
 	var zero int16
 
-	// types.go2:215
+	// types.go2:311
 	var test = Testable
 	test.Value.Set(zero)
 
@@ -490,7 +818,7 @@ func (t instantiate୦୦Type୦int16) Test(ctx *testing.T) {
 		Insert(test),
 	).Test(ctx)
 
-	// types.go2:223
+	// types.go2:319
 	var result = Testable
 
 	shouldNotError(
@@ -499,33 +827,117 @@ func (t instantiate୦୦Type୦int16) Test(ctx *testing.T) {
 
 	shouldBe(zero)(result.Value.Value()).Test(ctx)
 
-	// types.go2:232
+	// types.go2:328
 	shouldNotError(
 		If(Testable.Value.Equals(zero)).Update(
 			result.Value.To(zero),
 		),
 	).Test(ctx)
 
-	// types.go2:239
+	// types.go2:335
 	shouldNotError(
 		Delete(&Testable),
 	).Test(ctx)
 }
 
-// types.go2:242
+// types.go2:341
+type instantiate୦୦Ordered୦int16 struct {
+	// types.go2:342
+	instantiate୦୦Type୦int16
+}
+
+func (t instantiate୦୦Ordered୦int16) LessThan(val int16,
+
+// types.go2:345
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpLessThan,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦int16) GreaterThan(val int16,
+
+// types.go2:356
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpGreaterThan,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦int16) LessOrEqual(val int16,
+
+// types.go2:367
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpLessOrEqual,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦int16) GreaterOrEqual(val int16,
+
+// types.go2:378
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpGreaterOrEqual,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦int16) Between(min, max int16,
+
+// types.go2:389
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpBetween,
+		Value:    []int16{min, max},
+	}
+}
+
+func (t instantiate୦୦Ordered୦int16) On(other struct {
+	// types.go2:400
+	instantiate୦୦Ordered୦int16
+	// types.go2:400
+}) Linker {
+	return t.instantiate୦୦Type୦int16.On(struct{ instantiate୦୦Type୦int16 }{other.instantiate୦୦Type୦int16})
+}
+
+// types.go2:338
 type instantiate୦୦Type୦int32 struct {
-	// types.go2:34
+	// types.go2:56
 	driver        Driver
 	table, column string
 	view          Table
 
 	offset uintptr
 
-	key bool
+	tag tag
 
 	value int32
 
-	// types.go2:43
+	// types.go2:65
 	slice []int32
 
 	master *int32
@@ -556,11 +968,43 @@ func (t *instantiate୦୦Type୦int32) Master() bool {
 }
 
 func (t instantiate୦୦Type୦int32) Key() bool {
-	return t.key
+	return t.tag.key
+}
+
+func (t instantiate୦୦Type୦int32) Indexed() bool {
+	return t.tag.index
+}
+
+func (t instantiate୦୦Type୦int32) Auto() bool {
+	return t.tag.auto
+}
+
+func (t instantiate୦୦Type୦int32) Unique() bool {
+	return t.tag.unique
+}
+
+func (t instantiate୦୦Type୦int32) IndexName() string {
+	return t.tag.name
+}
+
+func (t instantiate୦୦Type୦int32) References() string {
+	return t.tag.references
+}
+
+func (t instantiate୦୦Type୦int32) OnDelete() string {
+	return t.tag.ondelete
+}
+
+func (t instantiate୦୦Type୦int32) Precision() int {
+	return t.tag.precision
+}
+
+func (t instantiate୦୦Type୦int32) Scale() int {
+	return t.tag.scale
 }
 
 func (t instantiate୦୦Type୦int32) String() string {
-	return fmt.Sprint(t.value)
+	return fmt.Sprint(deref(t.value))
 }
 
 func (t instantiate୦୦Type୦int32) Increasing() Sorter {
@@ -585,7 +1029,7 @@ func (t instantiate୦୦Type୦int32) Value() int32 {
 
 func (t instantiate୦୦Type୦int32) Equals(val int32,
 
-// types.go2:100
+// types.go2:154
 ) Condition {
 	return Condition{
 		Table:    t.table,
@@ -599,7 +1043,7 @@ func (t instantiate୦୦Type୦int32) Equals(val int32,
 
 func (t instantiate୦୦Type୦int32) NotEquals(val int32,
 
-// types.go2:111
+// types.go2:165
 ) Condition {
 	return Condition{
 		Table:    t.table,
@@ -611,6 +1055,54 @@ func (t instantiate୦୦Type୦int32) NotEquals(val int32,
 	}
 }
 
+func (t instantiate୦୦Type୦int32) In(values ...int32,
+
+// types.go2:176
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦int32) NotIn(values ...int32,
+
+// types.go2:187
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦int32) IsNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIsNull,
+	}
+}
+
+func (t instantiate୦୦Type୦int32) NotNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotNull,
+	}
+}
+
 func (t instantiate୦୦Type୦int32) MarshalJSON() ([]byte, error) {
 	return json.Marshal(t.value)
 }
@@ -652,7 +1144,7 @@ func (t *instantiate୦୦Type୦int32) Index(index int) bool {
 func (t *instantiate୦୦Type୦int32) setprivate(
 	table, column string,
 	offset uintptr,
-	key bool,
+	tag tag,
 	driver Driver,
 	view Table,
 ) {
@@ -660,21 +1152,21 @@ func (t *instantiate୦୦Type୦int32) setprivate(
 	t.table = table
 	t.offset = offset
 	t.column = column
-	t.key = key
+	t.tag = tag
 	t.driver = driver
 	t.view = view
 }
 
 func (t *instantiate୦୦Type୦int32) Set(val int32,
 
-// types.go2:176
+// types.go2:272
 ) {
 	t.value = val
 }
 
 func (t instantiate୦୦Type୦int32) To(val int32,
 
-// types.go2:180
+// types.go2:276
 ) Update {
 	return Update{
 		Table:  t.table,
@@ -692,10 +1184,10 @@ func (t instantiate୦୦Type୦int32) On(other struct{ instantiate୦୦Type୦
 	}
 }
 
-// types.go2:198
+// types.go2:294
 func (t instantiate୦୦Type୦int32) Test(ctx *testing.T) {
 	var Testable struct {
-		View `db:"testable"`
+		View `db:"typeable"`
 
 		Value instantiate୦୦Type୦int32
 	}
@@ -708,7 +1200,7 @@ func (t instantiate୦୦Type୦int32) Test(ctx *testing.T) {
 
 	var zero int32
 
-	// types.go2:215
+	// types.go2:311
 	var test = Testable
 	test.Value.Set(zero)
 
@@ -716,7 +1208,7 @@ func (t instantiate୦୦Type୦int32) Test(ctx *testing.T) {
 		Insert(test),
 	).Test(ctx)
 
-	// types.go2:223
+	// types.go2:319
 	var result = Testable
 
 	shouldNotError(
@@ -725,33 +1217,117 @@ func (t instantiate୦୦Type୦int32) Test(ctx *testing.T) {
 
 	shouldBe(zero)(result.Value.Value()).Test(ctx)
 
-	// types.go2:232
+	// types.go2:328
 	shouldNotError(
 		If(Testable.Value.Equals(zero)).Update(
 			result.Value.To(zero),
 		),
 	).Test(ctx)
 
-	// types.go2:239
+	// types.go2:335
 	shouldNotError(
 		Delete(&Testable),
 	).Test(ctx)
 }
 
-// types.go2:242
+// types.go2:341
+type instantiate୦୦Ordered୦int32 struct {
+	// types.go2:342
+	instantiate୦୦Type୦int32
+}
+
+func (t instantiate୦୦Ordered୦int32) LessThan(val int32,
+
+// types.go2:345
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpLessThan,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦int32) GreaterThan(val int32,
+
+// types.go2:356
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpGreaterThan,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦int32) LessOrEqual(val int32,
+
+// types.go2:367
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpLessOrEqual,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦int32) GreaterOrEqual(val int32,
+
+// types.go2:378
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpGreaterOrEqual,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦int32) Between(min, max int32,
+
+// types.go2:389
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpBetween,
+		Value:    []int32{min, max},
+	}
+}
+
+func (t instantiate୦୦Ordered୦int32) On(other struct {
+	// types.go2:400
+	instantiate୦୦Ordered୦int32
+	// types.go2:400
+}) Linker {
+	return t.instantiate୦୦Type୦int32.On(struct{ instantiate୦୦Type୦int32 }{other.instantiate୦୦Type୦int32})
+}
+
+// types.go2:338
 type instantiate୦୦Type୦int64 struct {
-	// types.go2:34
+	// types.go2:56
 	driver        Driver
 	table, column string
 	view          Table
 
 	offset uintptr
 
-	key bool
+	tag tag
 
 	value int64
 
-	// types.go2:43
+	// types.go2:65
 	slice []int64
 
 	master *int64
@@ -782,11 +1358,43 @@ func (t *instantiate୦୦Type୦int64) Master() bool {
 }
 
 func (t instantiate୦୦Type୦int64) Key() bool {
-	return t.key
+	return t.tag.key
+}
+
+func (t instantiate୦୦Type୦int64) Indexed() bool {
+	return t.tag.index
+}
+
+func (t instantiate୦୦Type୦int64) Auto() bool {
+	return t.tag.auto
+}
+
+func (t instantiate୦୦Type୦int64) Unique() bool {
+	return t.tag.unique
+}
+
+func (t instantiate୦୦Type୦int64) IndexName() string {
+	return t.tag.name
+}
+
+func (t instantiate୦୦Type୦int64) References() string {
+	return t.tag.references
+}
+
+func (t instantiate୦୦Type୦int64) OnDelete() string {
+	return t.tag.ondelete
+}
+
+func (t instantiate୦୦Type୦int64) Precision() int {
+	return t.tag.precision
+}
+
+func (t instantiate୦୦Type୦int64) Scale() int {
+	return t.tag.scale
 }
 
 func (t instantiate୦୦Type୦int64) String() string {
-	return fmt.Sprint(t.value)
+	return fmt.Sprint(deref(t.value))
 }
 
 func (t instantiate୦୦Type୦int64) Increasing() Sorter {
@@ -811,7 +1419,7 @@ func (t instantiate୦୦Type୦int64) Value() int64 {
 
 func (t instantiate୦୦Type୦int64) Equals(val int64,
 
-// types.go2:100
+// types.go2:154
 ) Condition {
 	return Condition{
 		Table:    t.table,
@@ -825,7 +1433,7 @@ func (t instantiate୦୦Type୦int64) Equals(val int64,
 
 func (t instantiate୦୦Type୦int64) NotEquals(val int64,
 
-// types.go2:111
+// types.go2:165
 ) Condition {
 	return Condition{
 		Table:    t.table,
@@ -837,6 +1445,54 @@ func (t instantiate୦୦Type୦int64) NotEquals(val int64,
 	}
 }
 
+func (t instantiate୦୦Type୦int64) In(values ...int64,
+
+// types.go2:176
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦int64) NotIn(values ...int64,
+
+// types.go2:187
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦int64) IsNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIsNull,
+	}
+}
+
+func (t instantiate୦୦Type୦int64) NotNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotNull,
+	}
+}
+
 func (t instantiate୦୦Type୦int64) MarshalJSON() ([]byte, error) {
 	return json.Marshal(t.value)
 }
@@ -878,7 +1534,7 @@ func (t *instantiate୦୦Type୦int64) Index(index int) bool {
 func (t *instantiate୦୦Type୦int64) setprivate(
 	table, column string,
 	offset uintptr,
-	key bool,
+	tag tag,
 	driver Driver,
 	view Table,
 ) {
@@ -886,21 +1542,21 @@ func (t *instantiate୦୦Type୦int64) setprivate(
 	t.table = table
 	t.offset = offset
 	t.column = column
-	t.key = key
+	t.tag = tag
 	t.driver = driver
 	t.view = view
 }
 
 func (t *instantiate୦୦Type୦int64) Set(val int64,
 
-// types.go2:176
+// types.go2:272
 ) {
 	t.value = val
 }
 
 func (t instantiate୦୦Type୦int64) To(val int64,
 
-// types.go2:180
+// types.go2:276
 ) Update {
 	return Update{
 		Table:  t.table,
@@ -918,10 +1574,10 @@ func (t instantiate୦୦Type୦int64) On(other struct{ instantiate୦୦Type୦
 	}
 }
 
-// types.go2:198
+// types.go2:294
 func (t instantiate୦୦Type୦int64) Test(ctx *testing.T) {
 	var Testable struct {
-		View `db:"testable"`
+		View `db:"typeable"`
 
 		Value instantiate୦୦Type୦int64
 	}
@@ -934,7 +1590,7 @@ func (t instantiate୦୦Type୦int64) Test(ctx *testing.T) {
 
 	var zero int64
 
-	// types.go2:215
+	// types.go2:311
 	var test = Testable
 	test.Value.Set(zero)
 
@@ -942,7 +1598,7 @@ func (t instantiate୦୦Type୦int64) Test(ctx *testing.T) {
 		Insert(test),
 	).Test(ctx)
 
-	// types.go2:223
+	// types.go2:319
 	var result = Testable
 
 	shouldNotError(
@@ -951,33 +1607,117 @@ func (t instantiate୦୦Type୦int64) Test(ctx *testing.T) {
 
 	shouldBe(zero)(result.Value.Value()).Test(ctx)
 
-	// types.go2:232
+	// types.go2:328
 	shouldNotError(
 		If(Testable.Value.Equals(zero)).Update(
 			result.Value.To(zero),
 		),
 	).Test(ctx)
 
-	// types.go2:239
+	// types.go2:335
 	shouldNotError(
 		Delete(&Testable),
 	).Test(ctx)
 }
 
-// types.go2:242
+// types.go2:341
+type instantiate୦୦Ordered୦int64 struct {
+	// types.go2:342
+	instantiate୦୦Type୦int64
+}
+
+func (t instantiate୦୦Ordered୦int64) LessThan(val int64,
+
+// types.go2:345
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpLessThan,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦int64) GreaterThan(val int64,
+
+// types.go2:356
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpGreaterThan,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦int64) LessOrEqual(val int64,
+
+// types.go2:367
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpLessOrEqual,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦int64) GreaterOrEqual(val int64,
+
+// types.go2:378
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpGreaterOrEqual,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦int64) Between(min, max int64,
+
+// types.go2:389
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpBetween,
+		Value:    []int64{min, max},
+	}
+}
+
+func (t instantiate୦୦Ordered୦int64) On(other struct {
+	// types.go2:400
+	instantiate୦୦Ordered୦int64
+	// types.go2:400
+}) Linker {
+	return t.instantiate୦୦Type୦int64.On(struct{ instantiate୦୦Type୦int64 }{other.instantiate୦୦Type୦int64})
+}
+
+// types.go2:338
 type instantiate୦୦Type୦float64 struct {
-	// types.go2:34
+	// types.go2:56
 	driver        Driver
 	table, column string
 	view          Table
 
 	offset uintptr
 
-	key bool
+	tag tag
 
 	value float64
 
-	// types.go2:43
+	// types.go2:65
 	slice []float64
 
 	master *float64
@@ -1008,11 +1748,43 @@ func (t *instantiate୦୦Type୦float64) Master() bool {
 }
 
 func (t instantiate୦୦Type୦float64) Key() bool {
-	return t.key
+	return t.tag.key
+}
+
+func (t instantiate୦୦Type୦float64) Indexed() bool {
+	return t.tag.index
+}
+
+func (t instantiate୦୦Type୦float64) Auto() bool {
+	return t.tag.auto
+}
+
+func (t instantiate୦୦Type୦float64) Unique() bool {
+	return t.tag.unique
+}
+
+func (t instantiate୦୦Type୦float64) IndexName() string {
+	return t.tag.name
+}
+
+func (t instantiate୦୦Type୦float64) References() string {
+	return t.tag.references
+}
+
+func (t instantiate୦୦Type୦float64) OnDelete() string {
+	return t.tag.ondelete
+}
+
+func (t instantiate୦୦Type୦float64) Precision() int {
+	return t.tag.precision
+}
+
+func (t instantiate୦୦Type୦float64) Scale() int {
+	return t.tag.scale
 }
 
 func (t instantiate୦୦Type୦float64) String() string {
-	return fmt.Sprint(t.value)
+	return fmt.Sprint(deref(t.value))
 }
 
 func (t instantiate୦୦Type୦float64) Increasing() Sorter {
@@ -1037,7 +1809,7 @@ func (t instantiate୦୦Type୦float64) Value() float64 {
 
 func (t instantiate୦୦Type୦float64) Equals(val float64,
 
-// types.go2:100
+// types.go2:154
 ) Condition {
 	return Condition{
 		Table:    t.table,
@@ -1051,7 +1823,7 @@ func (t instantiate୦୦Type୦float64) Equals(val float64,
 
 func (t instantiate୦୦Type୦float64) NotEquals(val float64,
 
-// types.go2:111
+// types.go2:165
 ) Condition {
 	return Condition{
 		Table:    t.table,
@@ -1063,15 +1835,63 @@ func (t instantiate୦୦Type୦float64) NotEquals(val float64,
 	}
 }
 
-func (t instantiate୦୦Type୦float64) MarshalJSON() ([]byte, error) {
-	return json.Marshal(t.value)
-}
+func (t instantiate୦୦Type୦float64) In(values ...float64,
 
-func (t instantiate୦୦Type୦float64) Interface() interface{} {
-	return t.value
+// types.go2:176
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIn,
+		Value:    values,
+	}
 }
 
-func (t instantiate୦୦Type୦float64) Type() reflect.Type {
+func (t instantiate୦୦Type୦float64) NotIn(values ...float64,
+
+// types.go2:187
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦float64) IsNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIsNull,
+	}
+}
+
+func (t instantiate୦୦Type୦float64) NotNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotNull,
+	}
+}
+
+func (t instantiate୦୦Type୦float64) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+
+func (t instantiate୦୦Type୦float64) Interface() interface{} {
+	return t.value
+}
+
+func (t instantiate୦୦Type୦float64) Type() reflect.Type {
 	return reflect.TypeOf([0]float64{}).Elem()
 }
 
@@ -1104,7 +1924,7 @@ func (t *instantiate୦୦Type୦float64) Index(index int) bool {
 func (t *instantiate୦୦Type୦float64) setprivate(
 	table, column string,
 	offset uintptr,
-	key bool,
+	tag tag,
 	driver Driver,
 	view Table,
 ) {
@@ -1112,21 +1932,21 @@ func (t *instantiate୦୦Type୦float64) setprivate(
 	t.table = table
 	t.offset = offset
 	t.column = column
-	t.key = key
+	t.tag = tag
 	t.driver = driver
 	t.view = view
 }
 
 func (t *instantiate୦୦Type୦float64) Set(val float64,
 
-// types.go2:176
+// types.go2:272
 ) {
 	t.value = val
 }
 
 func (t instantiate୦୦Type୦float64) To(val float64,
 
-// types.go2:180
+// types.go2:276
 ) Update {
 	return Update{
 		Table:  t.table,
@@ -1137,9 +1957,9 @@ func (t instantiate୦୦Type୦float64) To(val float64,
 }
 
 func (t instantiate୦୦Type୦float64) On(other struct {
-	// types.go2:189
+	// types.go2:285
 	instantiate୦୦Type୦float64
-	// types.go2:189
+	// types.go2:285
 }) Linker {
 	return Linker{
 		From: t,
@@ -1148,10 +1968,10 @@ func (t instantiate୦୦Type୦float64) On(other struct {
 	}
 }
 
-// types.go2:198
+// types.go2:294
 func (t instantiate୦୦Type୦float64) Test(ctx *testing.T) {
 	var Testable struct {
-		View `db:"testable"`
+		View `db:"typeable"`
 
 		Value instantiate୦୦Type୦float64
 	}
@@ -1164,7 +1984,7 @@ func (t instantiate୦୦Type୦float64) Test(ctx *testing.T) {
 
 	var zero float64
 
-	// types.go2:215
+	// types.go2:311
 	var test = Testable
 	test.Value.Set(zero)
 
@@ -1172,7 +1992,7 @@ func (t instantiate୦୦Type୦float64) Test(ctx *testing.T) {
 		Insert(test),
 	).Test(ctx)
 
-	// types.go2:223
+	// types.go2:319
 	var result = Testable
 
 	shouldNotError(
@@ -1181,33 +2001,119 @@ func (t instantiate୦୦Type୦float64) Test(ctx *testing.T) {
 
 	shouldBe(zero)(result.Value.Value()).Test(ctx)
 
-	// types.go2:232
+	// types.go2:328
 	shouldNotError(
 		If(Testable.Value.Equals(zero)).Update(
 			result.Value.To(zero),
 		),
 	).Test(ctx)
 
-	// types.go2:239
+	// types.go2:335
 	shouldNotError(
 		Delete(&Testable),
 	).Test(ctx)
 }
 
-// types.go2:242
+// types.go2:341
+type instantiate୦୦Ordered୦float64 struct {
+	// types.go2:342
+	instantiate୦୦Type୦float64
+}
+
+func (t instantiate୦୦Ordered୦float64) LessThan(val float64,
+
+// types.go2:345
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpLessThan,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦float64) GreaterThan(val float64,
+
+// types.go2:356
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpGreaterThan,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦float64) LessOrEqual(val float64,
+
+// types.go2:367
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpLessOrEqual,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦float64) GreaterOrEqual(val float64,
+
+// types.go2:378
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpGreaterOrEqual,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦float64) Between(min, max float64,
+
+// types.go2:389
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpBetween,
+		Value:    []float64{min, max},
+	}
+}
+
+func (t instantiate୦୦Ordered୦float64) On(other struct {
+	// types.go2:400
+	instantiate୦୦Ordered୦float64
+	// types.go2:400
+}) Linker {
+	return t.instantiate୦୦Type୦float64.On(struct {
+		instantiate୦୦Type୦float64
+	}{other.instantiate୦୦Type୦float64})
+}
+
+// types.go2:338
 type instantiate୦୦Type୦bool struct {
-	// types.go2:34
+	// types.go2:56
 	driver        Driver
 	table, column string
 	view          Table
 
 	offset uintptr
 
-	key bool
+	tag tag
 
 	value bool
 
-	// types.go2:43
+	// types.go2:65
 	slice []bool
 
 	master *bool
@@ -1238,11 +2144,43 @@ func (t *instantiate୦୦Type୦bool) Master() bool {
 }
 
 func (t instantiate୦୦Type୦bool) Key() bool {
-	return t.key
+	return t.tag.key
+}
+
+func (t instantiate୦୦Type୦bool) Indexed() bool {
+	return t.tag.index
+}
+
+func (t instantiate୦୦Type୦bool) Auto() bool {
+	return t.tag.auto
+}
+
+func (t instantiate୦୦Type୦bool) Unique() bool {
+	return t.tag.unique
+}
+
+func (t instantiate୦୦Type୦bool) IndexName() string {
+	return t.tag.name
+}
+
+func (t instantiate୦୦Type୦bool) References() string {
+	return t.tag.references
+}
+
+func (t instantiate୦୦Type୦bool) OnDelete() string {
+	return t.tag.ondelete
+}
+
+func (t instantiate୦୦Type୦bool) Precision() int {
+	return t.tag.precision
+}
+
+func (t instantiate୦୦Type୦bool) Scale() int {
+	return t.tag.scale
 }
 
 func (t instantiate୦୦Type୦bool) String() string {
-	return fmt.Sprint(t.value)
+	return fmt.Sprint(deref(t.value))
 }
 
 func (t instantiate୦୦Type୦bool) Increasing() Sorter {
@@ -1267,7 +2205,7 @@ func (t instantiate୦୦Type୦bool) Value() bool {
 
 func (t instantiate୦୦Type୦bool) Equals(val bool,
 
-// types.go2:100
+// types.go2:154
 ) Condition {
 	return Condition{
 		Table:    t.table,
@@ -1281,7 +2219,7 @@ func (t instantiate୦୦Type୦bool) Equals(val bool,
 
 func (t instantiate୦୦Type୦bool) NotEquals(val bool,
 
-// types.go2:111
+// types.go2:165
 ) Condition {
 	return Condition{
 		Table:    t.table,
@@ -1293,6 +2231,54 @@ func (t instantiate୦୦Type୦bool) NotEquals(val bool,
 	}
 }
 
+func (t instantiate୦୦Type୦bool) In(values ...bool,
+
+// types.go2:176
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦bool) NotIn(values ...bool,
+
+// types.go2:187
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦bool) IsNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIsNull,
+	}
+}
+
+func (t instantiate୦୦Type୦bool) NotNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotNull,
+	}
+}
+
 func (t instantiate୦୦Type୦bool) MarshalJSON() ([]byte, error) {
 	return json.Marshal(t.value)
 }
@@ -1334,7 +2320,7 @@ func (t *instantiate୦୦Type୦bool) Index(index int) bool {
 func (t *instantiate୦୦Type୦bool) setprivate(
 	table, column string,
 	offset uintptr,
-	key bool,
+	tag tag,
 	driver Driver,
 	view Table,
 ) {
@@ -1342,21 +2328,21 @@ func (t *instantiate୦୦Type୦bool) setprivate(
 	t.table = table
 	t.offset = offset
 	t.column = column
-	t.key = key
+	t.tag = tag
 	t.driver = driver
 	t.view = view
 }
 
 func (t *instantiate୦୦Type୦bool) Set(val bool,
 
-// types.go2:176
+// types.go2:272
 ) {
 	t.value = val
 }
 
 func (t instantiate୦୦Type୦bool) To(val bool,
 
-// types.go2:180
+// types.go2:276
 ) Update {
 	return Update{
 		Table:  t.table,
@@ -1374,10 +2360,10 @@ func (t instantiate୦୦Type୦bool) On(other struct{ instantiate୦୦Type୦b
 	}
 }
 
-// types.go2:198
+// types.go2:294
 func (t instantiate୦୦Type୦bool) Test(ctx *testing.T) {
 	var Testable struct {
-		View `db:"testable"`
+		View `db:"typeable"`
 
 		Value instantiate୦୦Type୦bool
 	}
@@ -1390,7 +2376,7 @@ func (t instantiate୦୦Type୦bool) Test(ctx *testing.T) {
 
 	var zero bool
 
-	// types.go2:215
+	// types.go2:311
 	var test = Testable
 	test.Value.Set(zero)
 
@@ -1398,7 +2384,7 @@ func (t instantiate୦୦Type୦bool) Test(ctx *testing.T) {
 		Insert(test),
 	).Test(ctx)
 
-	// types.go2:223
+	// types.go2:319
 	var result = Testable
 
 	shouldNotError(
@@ -1407,33 +2393,33 @@ func (t instantiate୦୦Type୦bool) Test(ctx *testing.T) {
 
 	shouldBe(zero)(result.Value.Value()).Test(ctx)
 
-	// types.go2:232
+	// types.go2:328
 	shouldNotError(
 		If(Testable.Value.Equals(zero)).Update(
 			result.Value.To(zero),
 		),
 	).Test(ctx)
 
-	// types.go2:239
+	// types.go2:335
 	shouldNotError(
 		Delete(&Testable),
 	).Test(ctx)
 }
 
-// types.go2:242
+// types.go2:338
 type instantiate୦୦Type୦୮6୮7byte struct {
-	// types.go2:34
+	// types.go2:56
 	driver        Driver
 	table, column string
 	view          Table
 
 	offset uintptr
 
-	key bool
+	tag tag
 
 	value []byte
 
-	// types.go2:43
+	// types.go2:65
 	slice [][]byte
 
 	master *[]byte
@@ -1464,11 +2450,43 @@ func (t *instantiate୦୦Type୦୮6୮7byte) Master() bool {
 }
 
 func (t instantiate୦୦Type୦୮6୮7byte) Key() bool {
-	return t.key
+	return t.tag.key
+}
+
+func (t instantiate୦୦Type୦୮6୮7byte) Indexed() bool {
+	return t.tag.index
+}
+
+func (t instantiate୦୦Type୦୮6୮7byte) Auto() bool {
+	return t.tag.auto
+}
+
+func (t instantiate୦୦Type୦୮6୮7byte) Unique() bool {
+	return t.tag.unique
+}
+
+func (t instantiate୦୦Type୦୮6୮7byte) IndexName() string {
+	return t.tag.name
+}
+
+func (t instantiate୦୦Type୦୮6୮7byte) References() string {
+	return t.tag.references
+}
+
+func (t instantiate୦୦Type୦୮6୮7byte) OnDelete() string {
+	return t.tag.ondelete
+}
+
+func (t instantiate୦୦Type୦୮6୮7byte) Precision() int {
+	return t.tag.precision
+}
+
+func (t instantiate୦୦Type୦୮6୮7byte) Scale() int {
+	return t.tag.scale
 }
 
 func (t instantiate୦୦Type୦୮6୮7byte) String() string {
-	return fmt.Sprint(t.value)
+	return fmt.Sprint(deref(t.value))
 }
 
 func (t instantiate୦୦Type୦୮6୮7byte) Increasing() Sorter {
@@ -1493,7 +2511,7 @@ func (t instantiate୦୦Type୦୮6୮7byte) Value() []byte {
 
 func (t instantiate୦୦Type୦୮6୮7byte) Equals(val []byte,
 
-// types.go2:100
+// types.go2:154
 ) Condition {
 	return Condition{
 		Table:    t.table,
@@ -1507,7 +2525,7 @@ func (t instantiate୦୦Type୦୮6୮7byte) Equals(val []byte,
 
 func (t instantiate୦୦Type୦୮6୮7byte) NotEquals(val []byte,
 
-// types.go2:111
+// types.go2:165
 ) Condition {
 	return Condition{
 		Table:    t.table,
@@ -1519,6 +2537,54 @@ func (t instantiate୦୦Type୦୮6୮7byte) NotEquals(val []byte,
 	}
 }
 
+func (t instantiate୦୦Type୦୮6୮7byte) In(values ...[]byte,
+
+// types.go2:176
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦୮6୮7byte) NotIn(values ...[]byte,
+
+// types.go2:187
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦୮6୮7byte) IsNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIsNull,
+	}
+}
+
+func (t instantiate୦୦Type୦୮6୮7byte) NotNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotNull,
+	}
+}
+
 func (t instantiate୦୦Type୦୮6୮7byte) MarshalJSON() ([]byte, error) {
 	return json.Marshal(t.value)
 }
@@ -1560,7 +2626,7 @@ func (t *instantiate୦୦Type୦୮6୮7byte) Index(index int) bool {
 func (t *instantiate୦୦Type୦୮6୮7byte) setprivate(
 	table, column string,
 	offset uintptr,
-	key bool,
+	tag tag,
 	driver Driver,
 	view Table,
 ) {
@@ -1568,21 +2634,21 @@ func (t *instantiate୦୦Type୦୮6୮7byte) setprivate(
 	t.table = table
 	t.offset = offset
 	t.column = column
-	t.key = key
+	t.tag = tag
 	t.driver = driver
 	t.view = view
 }
 
 func (t *instantiate୦୦Type୦୮6୮7byte) Set(val []byte,
 
-// types.go2:176
+// types.go2:272
 ) {
 	t.value = val
 }
 
 func (t instantiate୦୦Type୦୮6୮7byte) To(val []byte,
 
-// types.go2:180
+// types.go2:276
 ) Update {
 	return Update{
 		Table:  t.table,
@@ -1593,9 +2659,9 @@ func (t instantiate୦୦Type୦୮6୮7byte) To(val []byte,
 }
 
 func (t instantiate୦୦Type୦୮6୮7byte) On(other struct {
-	// types.go2:189
+	// types.go2:285
 	instantiate୦୦Type୦୮6୮7byte
-	// types.go2:189
+	// types.go2:285
 }) Linker {
 	return Linker{
 		From: t,
@@ -1604,10 +2670,10 @@ func (t instantiate୦୦Type୦୮6୮7byte) On(other struct {
 	}
 }
 
-// types.go2:198
+// types.go2:294
 func (t instantiate୦୦Type୦୮6୮7byte) Test(ctx *testing.T) {
 	var Testable struct {
-		View `db:"testable"`
+		View `db:"typeable"`
 
 		Value instantiate୦୦Type୦୮6୮7byte
 	}
@@ -1620,7 +2686,7 @@ func (t instantiate୦୦Type୦୮6୮7byte) Test(ctx *testing.T) {
 
 	var zero []byte
 
-	// types.go2:215
+	// types.go2:311
 	var test = Testable
 	test.Value.Set(zero)
 
@@ -1628,7 +2694,7 @@ func (t instantiate୦୦Type୦୮6୮7byte) Test(ctx *testing.T) {
 		Insert(test),
 	).Test(ctx)
 
-	// types.go2:223
+	// types.go2:319
 	var result = Testable
 
 	shouldNotError(
@@ -1637,33 +2703,33 @@ func (t instantiate୦୦Type୦୮6୮7byte) Test(ctx *testing.T) {
 
 	shouldBe(zero)(result.Value.Value()).Test(ctx)
 
-	// types.go2:232
+	// types.go2:328
 	shouldNotError(
 		If(Testable.Value.Equals(zero)).Update(
 			result.Value.To(zero),
 		),
 	).Test(ctx)
 
-	// types.go2:239
+	// types.go2:335
 	shouldNotError(
 		Delete(&Testable),
 	).Test(ctx)
 }
 
-// types.go2:242
+// types.go2:338
 type instantiate୦୦Type୦string struct {
-	// types.go2:34
+	// types.go2:56
 	driver        Driver
 	table, column string
 	view          Table
 
 	offset uintptr
 
-	key bool
+	tag tag
 
 	value string
 
-	// types.go2:43
+	// types.go2:65
 	slice []string
 
 	master *string
@@ -1694,11 +2760,43 @@ func (t *instantiate୦୦Type୦string) Master() bool {
 }
 
 func (t instantiate୦୦Type୦string) Key() bool {
-	return t.key
+	return t.tag.key
+}
+
+func (t instantiate୦୦Type୦string) Indexed() bool {
+	return t.tag.index
+}
+
+func (t instantiate୦୦Type୦string) Auto() bool {
+	return t.tag.auto
+}
+
+func (t instantiate୦୦Type୦string) Unique() bool {
+	return t.tag.unique
+}
+
+func (t instantiate୦୦Type୦string) IndexName() string {
+	return t.tag.name
+}
+
+func (t instantiate୦୦Type୦string) References() string {
+	return t.tag.references
+}
+
+func (t instantiate୦୦Type୦string) OnDelete() string {
+	return t.tag.ondelete
+}
+
+func (t instantiate୦୦Type୦string) Precision() int {
+	return t.tag.precision
+}
+
+func (t instantiate୦୦Type୦string) Scale() int {
+	return t.tag.scale
 }
 
 func (t instantiate୦୦Type୦string) String() string {
-	return fmt.Sprint(t.value)
+	return fmt.Sprint(deref(t.value))
 }
 
 func (t instantiate୦୦Type୦string) Increasing() Sorter {
@@ -1723,7 +2821,7 @@ func (t instantiate୦୦Type୦string) Value() string {
 
 func (t instantiate୦୦Type୦string) Equals(val string,
 
-// types.go2:100
+// types.go2:154
 ) Condition {
 	return Condition{
 		Table:    t.table,
@@ -1737,7 +2835,7 @@ func (t instantiate୦୦Type୦string) Equals(val string,
 
 func (t instantiate୦୦Type୦string) NotEquals(val string,
 
-// types.go2:111
+// types.go2:165
 ) Condition {
 	return Condition{
 		Table:    t.table,
@@ -1749,30 +2847,78 @@ func (t instantiate୦୦Type୦string) NotEquals(val string,
 	}
 }
 
-func (t instantiate୦୦Type୦string) MarshalJSON() ([]byte, error) {
-	return json.Marshal(t.value)
-}
+func (t instantiate୦୦Type୦string) In(values ...string,
 
-func (t instantiate୦୦Type୦string) Interface() interface{} {
-	return t.value
+// types.go2:176
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIn,
+		Value:    values,
+	}
 }
 
-func (t instantiate୦୦Type୦string) Type() reflect.Type {
-	return reflect.TypeOf([0]string{}).Elem()
-}
+func (t instantiate୦୦Type୦string) NotIn(values ...string,
 
-func (t *instantiate୦୦Type୦string) Pointer() interface{} {
-	return &t.value
+// types.go2:187
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotIn,
+		Value:    values,
+	}
 }
 
-func (t *instantiate୦୦Type୦string) Make(length int) interface{} {
-	if len(t.slice) != length {
-		t.slice = make([]string, length)
+func (t instantiate୦୦Type୦string) IsNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIsNull,
 	}
-	return t.slice
 }
 
-func (t *instantiate୦୦Type୦string) Slice(index int) interface{} {
+func (t instantiate୦୦Type୦string) NotNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotNull,
+	}
+}
+
+func (t instantiate୦୦Type୦string) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+
+func (t instantiate୦୦Type୦string) Interface() interface{} {
+	return t.value
+}
+
+func (t instantiate୦୦Type୦string) Type() reflect.Type {
+	return reflect.TypeOf([0]string{}).Elem()
+}
+
+func (t *instantiate୦୦Type୦string) Pointer() interface{} {
+	return &t.value
+}
+
+func (t *instantiate୦୦Type୦string) Make(length int) interface{} {
+	if len(t.slice) != length {
+		t.slice = make([]string, length)
+	}
+	return t.slice
+}
+
+func (t *instantiate୦୦Type୦string) Slice(index int) interface{} {
 	if index < len(t.slice) && index >= 0 {
 		return &t.slice[index]
 	}
@@ -1790,7 +2936,7 @@ func (t *instantiate୦୦Type୦string) Index(index int) bool {
 func (t *instantiate୦୦Type୦string) setprivate(
 	table, column string,
 	offset uintptr,
-	key bool,
+	tag tag,
 	driver Driver,
 	view Table,
 ) {
@@ -1798,21 +2944,21 @@ func (t *instantiate୦୦Type୦string) setprivate(
 	t.table = table
 	t.offset = offset
 	t.column = column
-	t.key = key
+	t.tag = tag
 	t.driver = driver
 	t.view = view
 }
 
 func (t *instantiate୦୦Type୦string) Set(val string,
 
-// types.go2:176
+// types.go2:272
 ) {
 	t.value = val
 }
 
 func (t instantiate୦୦Type୦string) To(val string,
 
-// types.go2:180
+// types.go2:276
 ) Update {
 	return Update{
 		Table:  t.table,
@@ -1830,10 +2976,10 @@ func (t instantiate୦୦Type୦string) On(other struct{ instantiate୦୦Type
 	}
 }
 
-// types.go2:198
+// types.go2:294
 func (t instantiate୦୦Type୦string) Test(ctx *testing.T) {
 	var Testable struct {
-		View `db:"testable"`
+		View `db:"typeable"`
 
 		Value instantiate୦୦Type୦string
 	}
@@ -1846,7 +2992,7 @@ func (t instantiate୦୦Type୦string) Test(ctx *testing.T) {
 
 	var zero string
 
-	// types.go2:215
+	// types.go2:311
 	var test = Testable
 	test.Value.Set(zero)
 
@@ -1854,7 +3000,7 @@ func (t instantiate୦୦Type୦string) Test(ctx *testing.T) {
 		Insert(test),
 	).Test(ctx)
 
-	// types.go2:223
+	// types.go2:319
 	var result = Testable
 
 	shouldNotError(
@@ -1863,33 +3009,117 @@ func (t instantiate୦୦Type୦string) Test(ctx *testing.T) {
 
 	shouldBe(zero)(result.Value.Value()).Test(ctx)
 
-	// types.go2:232
+	// types.go2:328
 	shouldNotError(
 		If(Testable.Value.Equals(zero)).Update(
 			result.Value.To(zero),
 		),
 	).Test(ctx)
 
-	// types.go2:239
+	// types.go2:335
 	shouldNotError(
 		Delete(&Testable),
 	).Test(ctx)
 }
 
-// types.go2:242
+// types.go2:341
+type instantiate୦୦Ordered୦string struct {
+	// types.go2:342
+	instantiate୦୦Type୦string
+}
+
+func (t instantiate୦୦Ordered୦string) LessThan(val string,
+
+// types.go2:345
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpLessThan,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦string) GreaterThan(val string,
+
+// types.go2:356
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpGreaterThan,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦string) LessOrEqual(val string,
+
+// types.go2:367
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpLessOrEqual,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦string) GreaterOrEqual(val string,
+
+// types.go2:378
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpGreaterOrEqual,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦string) Between(min, max string,
+
+// types.go2:389
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpBetween,
+		Value:    []string{min, max},
+	}
+}
+
+func (t instantiate୦୦Ordered୦string) On(other struct {
+	// types.go2:400
+	instantiate୦୦Ordered୦string
+	// types.go2:400
+}) Linker {
+	return t.instantiate୦୦Type୦string.On(struct{ instantiate୦୦Type୦string }{other.instantiate୦୦Type୦string})
+}
+
+// types.go2:338
 type instantiate୦୦Type୦time୮aTime struct {
-	// types.go2:34
+	// types.go2:56
 	driver        Driver
 	table, column string
 	view          Table
 
 	offset uintptr
 
-	key bool
+	tag tag
 
 	value time.Time
 
-	// types.go2:43
+	// types.go2:65
 	slice []time.Time
 
 	master *time.Time
@@ -1920,11 +3150,43 @@ func (t *instantiate୦୦Type୦time୮aTime) Master() bool {
 }
 
 func (t instantiate୦୦Type୦time୮aTime) Key() bool {
-	return t.key
+	return t.tag.key
+}
+
+func (t instantiate୦୦Type୦time୮aTime) Indexed() bool {
+	return t.tag.index
+}
+
+func (t instantiate୦୦Type୦time୮aTime) Auto() bool {
+	return t.tag.auto
+}
+
+func (t instantiate୦୦Type୦time୮aTime) Unique() bool {
+	return t.tag.unique
+}
+
+func (t instantiate୦୦Type୦time୮aTime) IndexName() string {
+	return t.tag.name
+}
+
+func (t instantiate୦୦Type୦time୮aTime) References() string {
+	return t.tag.references
+}
+
+func (t instantiate୦୦Type୦time୮aTime) OnDelete() string {
+	return t.tag.ondelete
+}
+
+func (t instantiate୦୦Type୦time୮aTime) Precision() int {
+	return t.tag.precision
+}
+
+func (t instantiate୦୦Type୦time୮aTime) Scale() int {
+	return t.tag.scale
 }
 
 func (t instantiate୦୦Type୦time୮aTime) String() string {
-	return fmt.Sprint(t.value)
+	return fmt.Sprint(deref(t.value))
 }
 
 func (t instantiate୦୦Type୦time୮aTime) Increasing() Sorter {
@@ -1949,7 +3211,7 @@ func (t instantiate୦୦Type୦time୮aTime) Value() time.Time {
 
 func (t instantiate୦୦Type୦time୮aTime) Equals(val time.Time,
 
-// types.go2:100
+// types.go2:154
 ) Condition {
 	return Condition{
 		Table:    t.table,
@@ -1963,7 +3225,7 @@ func (t instantiate୦୦Type୦time୮aTime) Equals(val time.Time,
 
 func (t instantiate୦୦Type୦time୮aTime) NotEquals(val time.Time,
 
-// types.go2:111
+// types.go2:165
 ) Condition {
 	return Condition{
 		Table:    t.table,
@@ -1975,6 +3237,54 @@ func (t instantiate୦୦Type୦time୮aTime) NotEquals(val time.Time,
 	}
 }
 
+func (t instantiate୦୦Type୦time୮aTime) In(values ...time.Time,
+
+// types.go2:176
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦time୮aTime) NotIn(values ...time.Time,
+
+// types.go2:187
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦time୮aTime) IsNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIsNull,
+	}
+}
+
+func (t instantiate୦୦Type୦time୮aTime) NotNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotNull,
+	}
+}
+
 func (t instantiate୦୦Type୦time୮aTime) MarshalJSON() ([]byte, error) {
 	return json.Marshal(t.value)
 }
@@ -2016,7 +3326,7 @@ func (t *instantiate୦୦Type୦time୮aTime) Index(index int) bool {
 func (t *instantiate୦୦Type୦time୮aTime) setprivate(
 	table, column string,
 	offset uintptr,
-	key bool,
+	tag tag,
 	driver Driver,
 	view Table,
 ) {
@@ -2024,21 +3334,21 @@ func (t *instantiate୦୦Type୦time୮aTime) setprivate(
 	t.table = table
 	t.offset = offset
 	t.column = column
-	t.key = key
+	t.tag = tag
 	t.driver = driver
 	t.view = view
 }
 
 func (t *instantiate୦୦Type୦time୮aTime) Set(val time.Time,
 
-// types.go2:176
+// types.go2:272
 ) {
 	t.value = val
 }
 
 func (t instantiate୦୦Type୦time୮aTime) To(val time.Time,
 
-// types.go2:180
+// types.go2:276
 ) Update {
 	return Update{
 		Table:  t.table,
@@ -2049,9 +3359,9 @@ func (t instantiate୦୦Type୦time୮aTime) To(val time.Time,
 }
 
 func (t instantiate୦୦Type୦time୮aTime) On(other struct {
-	// types.go2:189
+	// types.go2:285
 	instantiate୦୦Type୦time୮aTime
-	// types.go2:189
+	// types.go2:285
 }) Linker {
 	return Linker{
 		From: t,
@@ -2060,10 +3370,10 @@ func (t instantiate୦୦Type୦time୮aTime) On(other struct {
 	}
 }
 
-// types.go2:198
+// types.go2:294
 func (t instantiate୦୦Type୦time୮aTime) Test(ctx *testing.T) {
 	var Testable struct {
-		View `db:"testable"`
+		View `db:"typeable"`
 
 		Value instantiate୦୦Type୦time୮aTime
 	}
@@ -2076,7 +3386,7 @@ func (t instantiate୦୦Type୦time୮aTime) Test(ctx *testing.T) {
 
 	var zero time.Time
 
-	// types.go2:215
+	// types.go2:311
 	var test = Testable
 	test.Value.Set(zero)
 
@@ -2084,7 +3394,7 @@ func (t instantiate୦୦Type୦time୮aTime) Test(ctx *testing.T) {
 		Insert(test),
 	).Test(ctx)
 
-	// types.go2:223
+	// types.go2:319
 	var result = Testable
 
 	shouldNotError(
@@ -2093,33 +3403,119 @@ func (t instantiate୦୦Type୦time୮aTime) Test(ctx *testing.T) {
 
 	shouldBe(zero)(result.Value.Value()).Test(ctx)
 
-	// types.go2:232
+	// types.go2:328
 	shouldNotError(
 		If(Testable.Value.Equals(zero)).Update(
 			result.Value.To(zero),
 		),
 	).Test(ctx)
 
-	// types.go2:239
+	// types.go2:335
 	shouldNotError(
 		Delete(&Testable),
 	).Test(ctx)
 }
 
-// types.go2:242
+// types.go2:341
+type instantiate୦୦Ordered୦time୮aTime struct {
+	// types.go2:342
+	instantiate୦୦Type୦time୮aTime
+}
+
+func (t instantiate୦୦Ordered୦time୮aTime) LessThan(val time.Time,
+
+// types.go2:345
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpLessThan,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦time୮aTime) GreaterThan(val time.Time,
+
+// types.go2:356
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpGreaterThan,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦time୮aTime) LessOrEqual(val time.Time,
+
+// types.go2:367
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpLessOrEqual,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦time୮aTime) GreaterOrEqual(val time.Time,
+
+// types.go2:378
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpGreaterOrEqual,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦time୮aTime) Between(min, max time.Time,
+
+// types.go2:389
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpBetween,
+		Value:    []time.Time{min, max},
+	}
+}
+
+func (t instantiate୦୦Ordered୦time୮aTime) On(other struct {
+	// types.go2:400
+	instantiate୦୦Ordered୦time୮aTime
+	// types.go2:400
+}) Linker {
+	return t.instantiate୦୦Type୦time୮aTime.On(struct {
+		instantiate୦୦Type୦time୮aTime
+	}{other.instantiate୦୦Type୦time୮aTime})
+}
+
+// types.go2:338
 type instantiate୦୦Type୦db୮auid struct {
-	// types.go2:34
+	// types.go2:56
 	driver        Driver
 	table, column string
 	view          Table
 
 	offset uintptr
 
-	key bool
+	tag tag
 
 	value uid
 
-	// types.go2:43
+	// types.go2:65
 	slice []uid
 
 	master *uid
@@ -2150,11 +3546,43 @@ func (t *instantiate୦୦Type୦db୮auid) Master() bool {
 }
 
 func (t instantiate୦୦Type୦db୮auid) Key() bool {
-	return t.key
+	return t.tag.key
+}
+
+func (t instantiate୦୦Type୦db୮auid) Indexed() bool {
+	return t.tag.index
+}
+
+func (t instantiate୦୦Type୦db୮auid) Auto() bool {
+	return t.tag.auto
+}
+
+func (t instantiate୦୦Type୦db୮auid) Unique() bool {
+	return t.tag.unique
+}
+
+func (t instantiate୦୦Type୦db୮auid) IndexName() string {
+	return t.tag.name
+}
+
+func (t instantiate୦୦Type୦db୮auid) References() string {
+	return t.tag.references
+}
+
+func (t instantiate୦୦Type୦db୮auid) OnDelete() string {
+	return t.tag.ondelete
+}
+
+func (t instantiate୦୦Type୦db୮auid) Precision() int {
+	return t.tag.precision
+}
+
+func (t instantiate୦୦Type୦db୮auid) Scale() int {
+	return t.tag.scale
 }
 
 func (t instantiate୦୦Type୦db୮auid) String() string {
-	return fmt.Sprint(t.value)
+	return fmt.Sprint(deref(t.value))
 }
 
 func (t instantiate୦୦Type୦db୮auid) Increasing() Sorter {
@@ -2179,7 +3607,7 @@ func (t instantiate୦୦Type୦db୮auid) Value() uid {
 
 func (t instantiate୦୦Type୦db୮auid) Equals(val uid,
 
-// types.go2:100
+// types.go2:154
 ) Condition {
 	return Condition{
 		Table:    t.table,
@@ -2193,7 +3621,7 @@ func (t instantiate୦୦Type୦db୮auid) Equals(val uid,
 
 func (t instantiate୦୦Type୦db୮auid) NotEquals(val uid,
 
-// types.go2:111
+// types.go2:165
 ) Condition {
 	return Condition{
 		Table:    t.table,
@@ -2205,32 +3633,80 @@ func (t instantiate୦୦Type୦db୮auid) NotEquals(val uid,
 	}
 }
 
-func (t instantiate୦୦Type୦db୮auid) MarshalJSON() ([]byte, error) {
-	return json.Marshal(t.value)
-}
-
-func (t instantiate୦୦Type୦db୮auid) Interface() interface{} {
-	return t.value
-}
+func (t instantiate୦୦Type୦db୮auid) In(values ...uid,
 
-func (t instantiate୦୦Type୦db୮auid) Type() reflect.Type {
-	return reflect.TypeOf([0]uid{}).Elem()
+// types.go2:176
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIn,
+		Value:    values,
+	}
 }
 
-func (t *instantiate୦୦Type୦db୮auid) Pointer() interface{} {
-	return &t.value
-}
+func (t instantiate୦୦Type୦db୮auid) NotIn(values ...uid,
 
-func (t *instantiate୦୦Type୦db୮auid) Make(length int) interface{} {
-	if len(t.slice) != length {
-		t.slice = make([]uid, length)
+// types.go2:187
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotIn,
+		Value:    values,
 	}
-	return t.slice
 }
 
-func (t *instantiate୦୦Type୦db୮auid) Slice(index int) interface{} {
-	if index < len(t.slice) && index >= 0 {
-		return &t.slice[index]
+func (t instantiate୦୦Type୦db୮auid) IsNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIsNull,
+	}
+}
+
+func (t instantiate୦୦Type୦db୮auid) NotNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotNull,
+	}
+}
+
+func (t instantiate୦୦Type୦db୮auid) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+
+func (t instantiate୦୦Type୦db୮auid) Interface() interface{} {
+	return t.value
+}
+
+func (t instantiate୦୦Type୦db୮auid) Type() reflect.Type {
+	return reflect.TypeOf([0]uid{}).Elem()
+}
+
+func (t *instantiate୦୦Type୦db୮auid) Pointer() interface{} {
+	return &t.value
+}
+
+func (t *instantiate୦୦Type୦db୮auid) Make(length int) interface{} {
+	if len(t.slice) != length {
+		t.slice = make([]uid, length)
+	}
+	return t.slice
+}
+
+func (t *instantiate୦୦Type୦db୮auid) Slice(index int) interface{} {
+	if index < len(t.slice) && index >= 0 {
+		return &t.slice[index]
 	}
 	return nil
 }
@@ -2246,7 +3722,7 @@ func (t *instantiate୦୦Type୦db୮auid) Index(index int) bool {
 func (t *instantiate୦୦Type୦db୮auid) setprivate(
 	table, column string,
 	offset uintptr,
-	key bool,
+	tag tag,
 	driver Driver,
 	view Table,
 ) {
@@ -2254,21 +3730,21 @@ func (t *instantiate୦୦Type୦db୮auid) setprivate(
 	t.table = table
 	t.offset = offset
 	t.column = column
-	t.key = key
+	t.tag = tag
 	t.driver = driver
 	t.view = view
 }
 
 func (t *instantiate୦୦Type୦db୮auid) Set(val uid,
 
-// types.go2:176
+// types.go2:272
 ) {
 	t.value = val
 }
 
 func (t instantiate୦୦Type୦db୮auid) To(val uid,
 
-// types.go2:180
+// types.go2:276
 ) Update {
 	return Update{
 		Table:  t.table,
@@ -2279,9 +3755,9 @@ func (t instantiate୦୦Type୦db୮auid) To(val uid,
 }
 
 func (t instantiate୦୦Type୦db୮auid) On(other struct {
-	// types.go2:189
+	// types.go2:285
 	instantiate୦୦Type୦db୮auid
-	// types.go2:189
+	// types.go2:285
 }) Linker {
 	return Linker{
 		From: t,
@@ -2290,10 +3766,10 @@ func (t instantiate୦୦Type୦db୮auid) On(other struct {
 	}
 }
 
-// types.go2:198
+// types.go2:294
 func (t instantiate୦୦Type୦db୮auid) Test(ctx *testing.T) {
 	var Testable struct {
-		View `db:"testable"`
+		View `db:"typeable"`
 
 		Value instantiate୦୦Type୦db୮auid
 	}
@@ -2306,7 +3782,4649 @@ func (t instantiate୦୦Type୦db୮auid) Test(ctx *testing.T) {
 
 	var zero uid
 
-	// types.go2:215
+	// types.go2:311
+	var test = Testable
+	test.Value.Set(zero)
+
+	shouldNotError(
+		Insert(test),
+	).Test(ctx)
+
+	// types.go2:319
+	var result = Testable
+
+	shouldNotError(
+		If(Testable.Value.Equals(zero)).Get(&result),
+	).Test(ctx)
+
+	shouldBe(zero)(result.Value.Value()).Test(ctx)
+
+	// types.go2:328
+	shouldNotError(
+		If(Testable.Value.Equals(zero)).Update(
+			result.Value.To(zero),
+		),
+	).Test(ctx)
+
+	// types.go2:335
+	shouldNotError(
+		Delete(&Testable),
+	).Test(ctx)
+}
+
+// types.go2:341
+type instantiate୦୦Ordered୦db୮auid struct {
+	// types.go2:342
+	instantiate୦୦Type୦db୮auid
+}
+
+func (t instantiate୦୦Ordered୦db୮auid) LessThan(val uid,
+
+// types.go2:345
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpLessThan,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦db୮auid) GreaterThan(val uid,
+
+// types.go2:356
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpGreaterThan,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦db୮auid) LessOrEqual(val uid,
+
+// types.go2:367
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpLessOrEqual,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦db୮auid) GreaterOrEqual(val uid,
+
+// types.go2:378
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpGreaterOrEqual,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦db୮auid) Between(min, max uid,
+
+// types.go2:389
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpBetween,
+		Value:    []uid{min, max},
+	}
+}
+
+func (t instantiate୦୦Ordered୦db୮auid) On(other struct {
+	// types.go2:400
+	instantiate୦୦Ordered୦db୮auid
+	// types.go2:400
+}) Linker {
+	return t.instantiate୦୦Type୦db୮auid.On(struct {
+		instantiate୦୦Type୦db୮auid
+	}{other.instantiate୦୦Type୦db୮auid})
+}
+
+// types.go2:338
+type instantiate୦୦Type୦୮1int8 struct {
+	// types.go2:56
+	driver        Driver
+	table, column string
+	view          Table
+
+	offset uintptr
+
+	tag tag
+
+	value *int8
+
+	// types.go2:65
+	slice []*int8
+
+	master **int8
+}
+
+func (t instantiate୦୦Type୦୮1int8) Column() string {
+	return t.column
+}
+
+func (t instantiate୦୦Type୦୮1int8) Offset() uintptr {
+	return t.offset
+}
+
+func (t instantiate୦୦Type୦୮1int8) FieldName() string {
+	return t.column
+}
+
+func (t instantiate୦୦Type୦୮1int8) Database() Driver {
+	return t.driver
+}
+
+func (t instantiate୦୦Type୦୮1int8) Table() string {
+	return t.table
+}
+
+func (t *instantiate୦୦Type୦୮1int8) Master() bool {
+	return t.master == &t.value
+}
+
+func (t instantiate୦୦Type୦୮1int8) Key() bool {
+	return t.tag.key
+}
+
+func (t instantiate୦୦Type୦୮1int8) Indexed() bool {
+	return t.tag.index
+}
+
+func (t instantiate୦୦Type୦୮1int8) Auto() bool {
+	return t.tag.auto
+}
+
+func (t instantiate୦୦Type୦୮1int8) Unique() bool {
+	return t.tag.unique
+}
+
+func (t instantiate୦୦Type୦୮1int8) IndexName() string {
+	return t.tag.name
+}
+
+func (t instantiate୦୦Type୦୮1int8) References() string {
+	return t.tag.references
+}
+
+func (t instantiate୦୦Type୦୮1int8) OnDelete() string {
+	return t.tag.ondelete
+}
+
+func (t instantiate୦୦Type୦୮1int8) Precision() int {
+	return t.tag.precision
+}
+
+func (t instantiate୦୦Type୦୮1int8) Scale() int {
+	return t.tag.scale
+}
+
+func (t instantiate୦୦Type୦୮1int8) String() string {
+	return fmt.Sprint(deref(t.value))
+}
+
+func (t instantiate୦୦Type୦୮1int8) Increasing() Sorter {
+	return Sorter{
+		t.table,
+		t.column,
+		false,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1int8) Decreasing() Sorter {
+	return Sorter{
+		t.table,
+		t.column,
+		true,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1int8) Value() *int8 {
+	return t.value
+}
+
+func (t instantiate୦୦Type୦୮1int8) Equals(val *int8,
+
+// types.go2:154
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpEquals,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1int8) NotEquals(val *int8,
+
+// types.go2:165
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotEquals,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1int8) In(values ...*int8,
+
+// types.go2:176
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1int8) NotIn(values ...*int8,
+
+// types.go2:187
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1int8) IsNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIsNull,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1int8) NotNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotNull,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1int8) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+
+func (t instantiate୦୦Type୦୮1int8) Interface() interface{} {
+	return t.value
+}
+
+func (t instantiate୦୦Type୦୮1int8) Type() reflect.Type {
+	return reflect.TypeOf([0]*int8{}).Elem()
+}
+
+func (t *instantiate୦୦Type୦୮1int8) Pointer() interface{} {
+	return &t.value
+}
+
+func (t *instantiate୦୦Type୦୮1int8) Make(length int) interface{} {
+	if len(t.slice) != length {
+		t.slice = make([]*int8, length)
+	}
+	return t.slice
+}
+
+func (t *instantiate୦୦Type୦୮1int8) Slice(index int) interface{} {
+	if index < len(t.slice) && index >= 0 {
+		return &t.slice[index]
+	}
+	return nil
+}
+
+func (t *instantiate୦୦Type୦୮1int8) Index(index int) bool {
+	if index < len(t.slice) && index >= 0 {
+		t.value = t.slice[index]
+		return true
+	}
+	return false
+}
+
+func (t *instantiate୦୦Type୦୮1int8) setprivate(
+	table, column string,
+	offset uintptr,
+	tag tag,
+	driver Driver,
+	view Table,
+) {
+
+	t.table = table
+	t.offset = offset
+	t.column = column
+	t.tag = tag
+	t.driver = driver
+	t.view = view
+}
+
+func (t *instantiate୦୦Type୦୮1int8) Set(val *int8,
+
+// types.go2:272
+) {
+	t.value = val
+}
+
+func (t instantiate୦୦Type୦୮1int8) To(val *int8,
+
+// types.go2:276
+) Update {
+	return Update{
+		Table:  t.table,
+		driver: t.driver,
+		Column: t.column,
+		Value:  val,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1int8) On(other struct {
+	instantiate୦୦Type୦୮1int8
+}) Linker {
+	return Linker{
+		From: t,
+		To:   other,
+		View: t.view,
+	}
+}
+
+// types.go2:294
+func (t instantiate୦୦Type୦୮1int8) Test(ctx *testing.T) {
+	var Testable struct {
+		View `db:"typeable"`
+
+		Value instantiate୦୦Type୦୮1int8
+	}
+
+	defer Open().Connect(&Testable).Close()
+
+	shouldNotError(
+		Sync(Testable),
+	).Test(ctx)
+
+	var zero *int8
+
+	// types.go2:311
+	var test = Testable
+	test.Value.Set(zero)
+
+	shouldNotError(
+		Insert(test),
+	).Test(ctx)
+
+	// types.go2:319
+	var result = Testable
+
+	shouldNotError(
+		If(Testable.Value.Equals(zero)).Get(&result),
+	).Test(ctx)
+
+	shouldBe(zero)(result.Value.Value()).Test(ctx)
+
+	// types.go2:328
+	shouldNotError(
+		If(Testable.Value.Equals(zero)).Update(
+			result.Value.To(zero),
+		),
+	).Test(ctx)
+
+	// types.go2:335
+	shouldNotError(
+		Delete(&Testable),
+	).Test(ctx)
+}
+
+// types.go2:341
+type instantiate୦୦Ordered୦୮1int8 struct {
+	// types.go2:342
+	instantiate୦୦Type୦୮1int8
+}
+
+func (t instantiate୦୦Ordered୦୮1int8) LessThan(val *int8,
+
+// types.go2:345
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpLessThan,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1int8) GreaterThan(val *int8,
+
+// types.go2:356
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpGreaterThan,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1int8) LessOrEqual(val *int8,
+
+// types.go2:367
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpLessOrEqual,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1int8) GreaterOrEqual(val *int8,
+
+// types.go2:378
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpGreaterOrEqual,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1int8) Between(min, max *int8,
+
+// types.go2:389
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpBetween,
+		Value:    []*int8{min, max},
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1int8) On(other struct {
+	// types.go2:400
+	instantiate୦୦Ordered୦୮1int8
+	// types.go2:400
+}) Linker {
+	return t.instantiate୦୦Type୦୮1int8.On(struct {
+		instantiate୦୦Type୦୮1int8
+	}{other.instantiate୦୦Type୦୮1int8})
+}
+
+// types.go2:338
+type instantiate୦୦Type୦୮1int16 struct {
+	// types.go2:56
+	driver        Driver
+	table, column string
+	view          Table
+
+	offset uintptr
+
+	tag tag
+
+	value *int16
+
+	// types.go2:65
+	slice []*int16
+
+	master **int16
+}
+
+func (t instantiate୦୦Type୦୮1int16) Column() string {
+	return t.column
+}
+
+func (t instantiate୦୦Type୦୮1int16) Offset() uintptr {
+	return t.offset
+}
+
+func (t instantiate୦୦Type୦୮1int16) FieldName() string {
+	return t.column
+}
+
+func (t instantiate୦୦Type୦୮1int16) Database() Driver {
+	return t.driver
+}
+
+func (t instantiate୦୦Type୦୮1int16) Table() string {
+	return t.table
+}
+
+func (t *instantiate୦୦Type୦୮1int16) Master() bool {
+	return t.master == &t.value
+}
+
+func (t instantiate୦୦Type୦୮1int16) Key() bool {
+	return t.tag.key
+}
+
+func (t instantiate୦୦Type୦୮1int16) Indexed() bool {
+	return t.tag.index
+}
+
+func (t instantiate୦୦Type୦୮1int16) Auto() bool {
+	return t.tag.auto
+}
+
+func (t instantiate୦୦Type୦୮1int16) Unique() bool {
+	return t.tag.unique
+}
+
+func (t instantiate୦୦Type୦୮1int16) IndexName() string {
+	return t.tag.name
+}
+
+func (t instantiate୦୦Type୦୮1int16) References() string {
+	return t.tag.references
+}
+
+func (t instantiate୦୦Type୦୮1int16) OnDelete() string {
+	return t.tag.ondelete
+}
+
+func (t instantiate୦୦Type୦୮1int16) Precision() int {
+	return t.tag.precision
+}
+
+func (t instantiate୦୦Type୦୮1int16) Scale() int {
+	return t.tag.scale
+}
+
+func (t instantiate୦୦Type୦୮1int16) String() string {
+	return fmt.Sprint(deref(t.value))
+}
+
+func (t instantiate୦୦Type୦୮1int16) Increasing() Sorter {
+	return Sorter{
+		t.table,
+		t.column,
+		false,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1int16) Decreasing() Sorter {
+	return Sorter{
+		t.table,
+		t.column,
+		true,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1int16) Value() *int16 {
+	return t.value
+}
+
+func (t instantiate୦୦Type୦୮1int16) Equals(val *int16,
+
+// types.go2:154
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpEquals,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1int16) NotEquals(val *int16,
+
+// types.go2:165
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotEquals,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1int16) In(values ...*int16,
+
+// types.go2:176
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1int16) NotIn(values ...*int16,
+
+// types.go2:187
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1int16) IsNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIsNull,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1int16) NotNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotNull,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1int16) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+
+func (t instantiate୦୦Type୦୮1int16) Interface() interface{} {
+	return t.value
+}
+
+func (t instantiate୦୦Type୦୮1int16) Type() reflect.Type {
+	return reflect.TypeOf([0]*int16{}).Elem()
+}
+
+func (t *instantiate୦୦Type୦୮1int16) Pointer() interface{} {
+	return &t.value
+}
+
+func (t *instantiate୦୦Type୦୮1int16) Make(length int) interface{} {
+	if len(t.slice) != length {
+		t.slice = make([]*int16, length)
+	}
+	return t.slice
+}
+
+func (t *instantiate୦୦Type୦୮1int16) Slice(index int) interface{} {
+	if index < len(t.slice) && index >= 0 {
+		return &t.slice[index]
+	}
+	return nil
+}
+
+func (t *instantiate୦୦Type୦୮1int16) Index(index int) bool {
+	if index < len(t.slice) && index >= 0 {
+		t.value = t.slice[index]
+		return true
+	}
+	return false
+}
+
+func (t *instantiate୦୦Type୦୮1int16) setprivate(
+	table, column string,
+	offset uintptr,
+	tag tag,
+	driver Driver,
+	view Table,
+) {
+
+	t.table = table
+	t.offset = offset
+	t.column = column
+	t.tag = tag
+	t.driver = driver
+	t.view = view
+}
+
+func (t *instantiate୦୦Type୦୮1int16) Set(val *int16,
+
+// types.go2:272
+) {
+	t.value = val
+}
+
+func (t instantiate୦୦Type୦୮1int16) To(val *int16,
+
+// types.go2:276
+) Update {
+	return Update{
+		Table:  t.table,
+		driver: t.driver,
+		Column: t.column,
+		Value:  val,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1int16) On(other struct {
+	instantiate୦୦Type୦୮1int16
+}) Linker {
+	return Linker{
+		From: t,
+		To:   other,
+		View: t.view,
+	}
+}
+
+// types.go2:294
+func (t instantiate୦୦Type୦୮1int16) Test(ctx *testing.T) {
+	var Testable struct {
+		View `db:"typeable"`
+
+		Value instantiate୦୦Type୦୮1int16
+	}
+
+	defer Open().Connect(&Testable).Close()
+
+	shouldNotError(
+		Sync(Testable),
+	).Test(ctx)
+
+	var zero *int16
+
+	// types.go2:311
+	var test = Testable
+	test.Value.Set(zero)
+
+	shouldNotError(
+		Insert(test),
+	).Test(ctx)
+
+	// types.go2:319
+	var result = Testable
+
+	shouldNotError(
+		If(Testable.Value.Equals(zero)).Get(&result),
+	).Test(ctx)
+
+	shouldBe(zero)(result.Value.Value()).Test(ctx)
+
+	// types.go2:328
+	shouldNotError(
+		If(Testable.Value.Equals(zero)).Update(
+			result.Value.To(zero),
+		),
+	).Test(ctx)
+
+	// types.go2:335
+	shouldNotError(
+		Delete(&Testable),
+	).Test(ctx)
+}
+
+// types.go2:341
+type instantiate୦୦Ordered୦୮1int16 struct {
+	// types.go2:342
+	instantiate୦୦Type୦୮1int16
+}
+
+func (t instantiate୦୦Ordered୦୮1int16) LessThan(val *int16,
+
+// types.go2:345
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpLessThan,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1int16) GreaterThan(val *int16,
+
+// types.go2:356
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpGreaterThan,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1int16) LessOrEqual(val *int16,
+
+// types.go2:367
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpLessOrEqual,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1int16) GreaterOrEqual(val *int16,
+
+// types.go2:378
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpGreaterOrEqual,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1int16) Between(min, max *int16,
+
+// types.go2:389
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpBetween,
+		Value:    []*int16{min, max},
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1int16) On(other struct {
+	// types.go2:400
+	instantiate୦୦Ordered୦୮1int16
+	// types.go2:400
+}) Linker {
+	return t.instantiate୦୦Type୦୮1int16.On(struct {
+		instantiate୦୦Type୦୮1int16
+	}{other.instantiate୦୦Type୦୮1int16})
+}
+
+// types.go2:338
+type instantiate୦୦Type୦୮1int32 struct {
+	// types.go2:56
+	driver        Driver
+	table, column string
+	view          Table
+
+	offset uintptr
+
+	tag tag
+
+	value *int32
+
+	// types.go2:65
+	slice []*int32
+
+	master **int32
+}
+
+func (t instantiate୦୦Type୦୮1int32) Column() string {
+	return t.column
+}
+
+func (t instantiate୦୦Type୦୮1int32) Offset() uintptr {
+	return t.offset
+}
+
+func (t instantiate୦୦Type୦୮1int32) FieldName() string {
+	return t.column
+}
+
+func (t instantiate୦୦Type୦୮1int32) Database() Driver {
+	return t.driver
+}
+
+func (t instantiate୦୦Type୦୮1int32) Table() string {
+	return t.table
+}
+
+func (t *instantiate୦୦Type୦୮1int32) Master() bool {
+	return t.master == &t.value
+}
+
+func (t instantiate୦୦Type୦୮1int32) Key() bool {
+	return t.tag.key
+}
+
+func (t instantiate୦୦Type୦୮1int32) Indexed() bool {
+	return t.tag.index
+}
+
+func (t instantiate୦୦Type୦୮1int32) Auto() bool {
+	return t.tag.auto
+}
+
+func (t instantiate୦୦Type୦୮1int32) Unique() bool {
+	return t.tag.unique
+}
+
+func (t instantiate୦୦Type୦୮1int32) IndexName() string {
+	return t.tag.name
+}
+
+func (t instantiate୦୦Type୦୮1int32) References() string {
+	return t.tag.references
+}
+
+func (t instantiate୦୦Type୦୮1int32) OnDelete() string {
+	return t.tag.ondelete
+}
+
+func (t instantiate୦୦Type୦୮1int32) Precision() int {
+	return t.tag.precision
+}
+
+func (t instantiate୦୦Type୦୮1int32) Scale() int {
+	return t.tag.scale
+}
+
+func (t instantiate୦୦Type୦୮1int32) String() string {
+	return fmt.Sprint(deref(t.value))
+}
+
+func (t instantiate୦୦Type୦୮1int32) Increasing() Sorter {
+	return Sorter{
+		t.table,
+		t.column,
+		false,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1int32) Decreasing() Sorter {
+	return Sorter{
+		t.table,
+		t.column,
+		true,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1int32) Value() *int32 {
+	return t.value
+}
+
+func (t instantiate୦୦Type୦୮1int32) Equals(val *int32,
+
+// types.go2:154
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpEquals,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1int32) NotEquals(val *int32,
+
+// types.go2:165
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotEquals,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1int32) In(values ...*int32,
+
+// types.go2:176
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1int32) NotIn(values ...*int32,
+
+// types.go2:187
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1int32) IsNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIsNull,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1int32) NotNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotNull,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1int32) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+
+func (t instantiate୦୦Type୦୮1int32) Interface() interface{} {
+	return t.value
+}
+
+func (t instantiate୦୦Type୦୮1int32) Type() reflect.Type {
+	return reflect.TypeOf([0]*int32{}).Elem()
+}
+
+func (t *instantiate୦୦Type୦୮1int32) Pointer() interface{} {
+	return &t.value
+}
+
+func (t *instantiate୦୦Type୦୮1int32) Make(length int) interface{} {
+	if len(t.slice) != length {
+		t.slice = make([]*int32, length)
+	}
+	return t.slice
+}
+
+func (t *instantiate୦୦Type୦୮1int32) Slice(index int) interface{} {
+	if index < len(t.slice) && index >= 0 {
+		return &t.slice[index]
+	}
+	return nil
+}
+
+func (t *instantiate୦୦Type୦୮1int32) Index(index int) bool {
+	if index < len(t.slice) && index >= 0 {
+		t.value = t.slice[index]
+		return true
+	}
+	return false
+}
+
+func (t *instantiate୦୦Type୦୮1int32) setprivate(
+	table, column string,
+	offset uintptr,
+	tag tag,
+	driver Driver,
+	view Table,
+) {
+
+	t.table = table
+	t.offset = offset
+	t.column = column
+	t.tag = tag
+	t.driver = driver
+	t.view = view
+}
+
+func (t *instantiate୦୦Type୦୮1int32) Set(val *int32,
+
+// types.go2:272
+) {
+	t.value = val
+}
+
+func (t instantiate୦୦Type୦୮1int32) To(val *int32,
+
+// types.go2:276
+) Update {
+	return Update{
+		Table:  t.table,
+		driver: t.driver,
+		Column: t.column,
+		Value:  val,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1int32) On(other struct {
+	instantiate୦୦Type୦୮1int32
+}) Linker {
+	return Linker{
+		From: t,
+		To:   other,
+		View: t.view,
+	}
+}
+
+// types.go2:294
+func (t instantiate୦୦Type୦୮1int32) Test(ctx *testing.T) {
+	var Testable struct {
+		View `db:"typeable"`
+
+		Value instantiate୦୦Type୦୮1int32
+	}
+
+	defer Open().Connect(&Testable).Close()
+
+	shouldNotError(
+		Sync(Testable),
+	).Test(ctx)
+
+	var zero *int32
+
+	// types.go2:311
+	var test = Testable
+	test.Value.Set(zero)
+
+	shouldNotError(
+		Insert(test),
+	).Test(ctx)
+
+	// types.go2:319
+	var result = Testable
+
+	shouldNotError(
+		If(Testable.Value.Equals(zero)).Get(&result),
+	).Test(ctx)
+
+	shouldBe(zero)(result.Value.Value()).Test(ctx)
+
+	// types.go2:328
+	shouldNotError(
+		If(Testable.Value.Equals(zero)).Update(
+			result.Value.To(zero),
+		),
+	).Test(ctx)
+
+	// types.go2:335
+	shouldNotError(
+		Delete(&Testable),
+	).Test(ctx)
+}
+
+// types.go2:341
+type instantiate୦୦Ordered୦୮1int32 struct {
+	// types.go2:342
+	instantiate୦୦Type୦୮1int32
+}
+
+func (t instantiate୦୦Ordered୦୮1int32) LessThan(val *int32,
+
+// types.go2:345
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpLessThan,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1int32) GreaterThan(val *int32,
+
+// types.go2:356
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpGreaterThan,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1int32) LessOrEqual(val *int32,
+
+// types.go2:367
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpLessOrEqual,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1int32) GreaterOrEqual(val *int32,
+
+// types.go2:378
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpGreaterOrEqual,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1int32) Between(min, max *int32,
+
+// types.go2:389
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpBetween,
+		Value:    []*int32{min, max},
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1int32) On(other struct {
+	// types.go2:400
+	instantiate୦୦Ordered୦୮1int32
+	// types.go2:400
+}) Linker {
+	return t.instantiate୦୦Type୦୮1int32.On(struct {
+		instantiate୦୦Type୦୮1int32
+	}{other.instantiate୦୦Type୦୮1int32})
+}
+
+// types.go2:338
+type instantiate୦୦Type୦୮1int64 struct {
+	// types.go2:56
+	driver        Driver
+	table, column string
+	view          Table
+
+	offset uintptr
+
+	tag tag
+
+	value *int64
+
+	// types.go2:65
+	slice []*int64
+
+	master **int64
+}
+
+func (t instantiate୦୦Type୦୮1int64) Column() string {
+	return t.column
+}
+
+func (t instantiate୦୦Type୦୮1int64) Offset() uintptr {
+	return t.offset
+}
+
+func (t instantiate୦୦Type୦୮1int64) FieldName() string {
+	return t.column
+}
+
+func (t instantiate୦୦Type୦୮1int64) Database() Driver {
+	return t.driver
+}
+
+func (t instantiate୦୦Type୦୮1int64) Table() string {
+	return t.table
+}
+
+func (t *instantiate୦୦Type୦୮1int64) Master() bool {
+	return t.master == &t.value
+}
+
+func (t instantiate୦୦Type୦୮1int64) Key() bool {
+	return t.tag.key
+}
+
+func (t instantiate୦୦Type୦୮1int64) Indexed() bool {
+	return t.tag.index
+}
+
+func (t instantiate୦୦Type୦୮1int64) Auto() bool {
+	return t.tag.auto
+}
+
+func (t instantiate୦୦Type୦୮1int64) Unique() bool {
+	return t.tag.unique
+}
+
+func (t instantiate୦୦Type୦୮1int64) IndexName() string {
+	return t.tag.name
+}
+
+func (t instantiate୦୦Type୦୮1int64) References() string {
+	return t.tag.references
+}
+
+func (t instantiate୦୦Type୦୮1int64) OnDelete() string {
+	return t.tag.ondelete
+}
+
+func (t instantiate୦୦Type୦୮1int64) Precision() int {
+	return t.tag.precision
+}
+
+func (t instantiate୦୦Type୦୮1int64) Scale() int {
+	return t.tag.scale
+}
+
+func (t instantiate୦୦Type୦୮1int64) String() string {
+	return fmt.Sprint(deref(t.value))
+}
+
+func (t instantiate୦୦Type୦୮1int64) Increasing() Sorter {
+	return Sorter{
+		t.table,
+		t.column,
+		false,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1int64) Decreasing() Sorter {
+	return Sorter{
+		t.table,
+		t.column,
+		true,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1int64) Value() *int64 {
+	return t.value
+}
+
+func (t instantiate୦୦Type୦୮1int64) Equals(val *int64,
+
+// types.go2:154
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpEquals,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1int64) NotEquals(val *int64,
+
+// types.go2:165
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotEquals,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1int64) In(values ...*int64,
+
+// types.go2:176
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1int64) NotIn(values ...*int64,
+
+// types.go2:187
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1int64) IsNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIsNull,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1int64) NotNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotNull,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1int64) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+
+func (t instantiate୦୦Type୦୮1int64) Interface() interface{} {
+	return t.value
+}
+
+func (t instantiate୦୦Type୦୮1int64) Type() reflect.Type {
+	return reflect.TypeOf([0]*int64{}).Elem()
+}
+
+func (t *instantiate୦୦Type୦୮1int64) Pointer() interface{} {
+	return &t.value
+}
+
+func (t *instantiate୦୦Type୦୮1int64) Make(length int) interface{} {
+	if len(t.slice) != length {
+		t.slice = make([]*int64, length)
+	}
+	return t.slice
+}
+
+func (t *instantiate୦୦Type୦୮1int64) Slice(index int) interface{} {
+	if index < len(t.slice) && index >= 0 {
+		return &t.slice[index]
+	}
+	return nil
+}
+
+func (t *instantiate୦୦Type୦୮1int64) Index(index int) bool {
+	if index < len(t.slice) && index >= 0 {
+		t.value = t.slice[index]
+		return true
+	}
+	return false
+}
+
+func (t *instantiate୦୦Type୦୮1int64) setprivate(
+	table, column string,
+	offset uintptr,
+	tag tag,
+	driver Driver,
+	view Table,
+) {
+
+	t.table = table
+	t.offset = offset
+	t.column = column
+	t.tag = tag
+	t.driver = driver
+	t.view = view
+}
+
+func (t *instantiate୦୦Type୦୮1int64) Set(val *int64,
+
+// types.go2:272
+) {
+	t.value = val
+}
+
+func (t instantiate୦୦Type୦୮1int64) To(val *int64,
+
+// types.go2:276
+) Update {
+	return Update{
+		Table:  t.table,
+		driver: t.driver,
+		Column: t.column,
+		Value:  val,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1int64) On(other struct {
+	instantiate୦୦Type୦୮1int64
+}) Linker {
+	return Linker{
+		From: t,
+		To:   other,
+		View: t.view,
+	}
+}
+
+// types.go2:294
+func (t instantiate୦୦Type୦୮1int64) Test(ctx *testing.T) {
+	var Testable struct {
+		View `db:"typeable"`
+
+		Value instantiate୦୦Type୦୮1int64
+	}
+
+	defer Open().Connect(&Testable).Close()
+
+	shouldNotError(
+		Sync(Testable),
+	).Test(ctx)
+
+	var zero *int64
+
+	// types.go2:311
+	var test = Testable
+	test.Value.Set(zero)
+
+	shouldNotError(
+		Insert(test),
+	).Test(ctx)
+
+	// types.go2:319
+	var result = Testable
+
+	shouldNotError(
+		If(Testable.Value.Equals(zero)).Get(&result),
+	).Test(ctx)
+
+	shouldBe(zero)(result.Value.Value()).Test(ctx)
+
+	// types.go2:328
+	shouldNotError(
+		If(Testable.Value.Equals(zero)).Update(
+			result.Value.To(zero),
+		),
+	).Test(ctx)
+
+	// types.go2:335
+	shouldNotError(
+		Delete(&Testable),
+	).Test(ctx)
+}
+
+// types.go2:341
+type instantiate୦୦Ordered୦୮1int64 struct {
+	// types.go2:342
+	instantiate୦୦Type୦୮1int64
+}
+
+func (t instantiate୦୦Ordered୦୮1int64) LessThan(val *int64,
+
+// types.go2:345
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpLessThan,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1int64) GreaterThan(val *int64,
+
+// types.go2:356
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpGreaterThan,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1int64) LessOrEqual(val *int64,
+
+// types.go2:367
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpLessOrEqual,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1int64) GreaterOrEqual(val *int64,
+
+// types.go2:378
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpGreaterOrEqual,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1int64) Between(min, max *int64,
+
+// types.go2:389
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpBetween,
+		Value:    []*int64{min, max},
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1int64) On(other struct {
+	// types.go2:400
+	instantiate୦୦Ordered୦୮1int64
+	// types.go2:400
+}) Linker {
+	return t.instantiate୦୦Type୦୮1int64.On(struct {
+		instantiate୦୦Type୦୮1int64
+	}{other.instantiate୦୦Type୦୮1int64})
+}
+
+// types.go2:338
+type instantiate୦୦Type୦୮1float64 struct {
+	// types.go2:56
+	driver        Driver
+	table, column string
+	view          Table
+
+	offset uintptr
+
+	tag tag
+
+	value *float64
+
+	// types.go2:65
+	slice []*float64
+
+	master **float64
+}
+
+func (t instantiate୦୦Type୦୮1float64) Column() string {
+	return t.column
+}
+
+func (t instantiate୦୦Type୦୮1float64) Offset() uintptr {
+	return t.offset
+}
+
+func (t instantiate୦୦Type୦୮1float64) FieldName() string {
+	return t.column
+}
+
+func (t instantiate୦୦Type୦୮1float64) Database() Driver {
+	return t.driver
+}
+
+func (t instantiate୦୦Type୦୮1float64) Table() string {
+	return t.table
+}
+
+func (t *instantiate୦୦Type୦୮1float64) Master() bool {
+	return t.master == &t.value
+}
+
+func (t instantiate୦୦Type୦୮1float64) Key() bool {
+	return t.tag.key
+}
+
+func (t instantiate୦୦Type୦୮1float64) Indexed() bool {
+	return t.tag.index
+}
+
+func (t instantiate୦୦Type୦୮1float64) Auto() bool {
+	return t.tag.auto
+}
+
+func (t instantiate୦୦Type୦୮1float64) Unique() bool {
+	return t.tag.unique
+}
+
+func (t instantiate୦୦Type୦୮1float64) IndexName() string {
+	return t.tag.name
+}
+
+func (t instantiate୦୦Type୦୮1float64) References() string {
+	return t.tag.references
+}
+
+func (t instantiate୦୦Type୦୮1float64) OnDelete() string {
+	return t.tag.ondelete
+}
+
+func (t instantiate୦୦Type୦୮1float64) Precision() int {
+	return t.tag.precision
+}
+
+func (t instantiate୦୦Type୦୮1float64) Scale() int {
+	return t.tag.scale
+}
+
+func (t instantiate୦୦Type୦୮1float64) String() string {
+	return fmt.Sprint(deref(t.value))
+}
+
+func (t instantiate୦୦Type୦୮1float64) Increasing() Sorter {
+	return Sorter{
+		t.table,
+		t.column,
+		false,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1float64) Decreasing() Sorter {
+	return Sorter{
+		t.table,
+		t.column,
+		true,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1float64) Value() *float64 {
+	return t.value
+}
+
+func (t instantiate୦୦Type୦୮1float64) Equals(val *float64,
+
+// types.go2:154
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpEquals,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1float64) NotEquals(val *float64,
+
+// types.go2:165
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotEquals,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1float64) In(values ...*float64,
+
+// types.go2:176
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1float64) NotIn(values ...*float64,
+
+// types.go2:187
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1float64) IsNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIsNull,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1float64) NotNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotNull,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1float64) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+
+func (t instantiate୦୦Type୦୮1float64) Interface() interface{} {
+	return t.value
+}
+
+func (t instantiate୦୦Type୦୮1float64) Type() reflect.Type {
+	return reflect.TypeOf([0]*float64{}).Elem()
+}
+
+func (t *instantiate୦୦Type୦୮1float64) Pointer() interface{} {
+	return &t.value
+}
+
+func (t *instantiate୦୦Type୦୮1float64) Make(length int) interface{} {
+	if len(t.slice) != length {
+		t.slice = make([]*float64, length)
+	}
+	return t.slice
+}
+
+func (t *instantiate୦୦Type୦୮1float64) Slice(index int) interface{} {
+	if index < len(t.slice) && index >= 0 {
+		return &t.slice[index]
+	}
+	return nil
+}
+
+func (t *instantiate୦୦Type୦୮1float64) Index(index int) bool {
+	if index < len(t.slice) && index >= 0 {
+		t.value = t.slice[index]
+		return true
+	}
+	return false
+}
+
+func (t *instantiate୦୦Type୦୮1float64) setprivate(
+	table, column string,
+	offset uintptr,
+	tag tag,
+	driver Driver,
+	view Table,
+) {
+
+	t.table = table
+	t.offset = offset
+	t.column = column
+	t.tag = tag
+	t.driver = driver
+	t.view = view
+}
+
+func (t *instantiate୦୦Type୦୮1float64) Set(val *float64,
+
+// types.go2:272
+) {
+	t.value = val
+}
+
+func (t instantiate୦୦Type୦୮1float64) To(val *float64,
+
+// types.go2:276
+) Update {
+	return Update{
+		Table:  t.table,
+		driver: t.driver,
+		Column: t.column,
+		Value:  val,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1float64) On(other struct {
+	instantiate୦୦Type୦୮1float64
+}) Linker {
+	return Linker{
+		From: t,
+		To:   other,
+		View: t.view,
+	}
+}
+
+// types.go2:294
+func (t instantiate୦୦Type୦୮1float64) Test(ctx *testing.T) {
+	var Testable struct {
+		View `db:"typeable"`
+
+		Value instantiate୦୦Type୦୮1float64
+	}
+
+	defer Open().Connect(&Testable).Close()
+
+	shouldNotError(
+		Sync(Testable),
+	).Test(ctx)
+
+	var zero *float64
+
+	// types.go2:311
+	var test = Testable
+	test.Value.Set(zero)
+
+	shouldNotError(
+		Insert(test),
+	).Test(ctx)
+
+	// types.go2:319
+	var result = Testable
+
+	shouldNotError(
+		If(Testable.Value.Equals(zero)).Get(&result),
+	).Test(ctx)
+
+	shouldBe(zero)(result.Value.Value()).Test(ctx)
+
+	// types.go2:328
+	shouldNotError(
+		If(Testable.Value.Equals(zero)).Update(
+			result.Value.To(zero),
+		),
+	).Test(ctx)
+
+	// types.go2:335
+	shouldNotError(
+		Delete(&Testable),
+	).Test(ctx)
+}
+
+// types.go2:341
+type instantiate୦୦Ordered୦୮1float64 struct {
+	// types.go2:342
+	instantiate୦୦Type୦୮1float64
+}
+
+func (t instantiate୦୦Ordered୦୮1float64) LessThan(val *float64,
+
+// types.go2:345
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpLessThan,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1float64) GreaterThan(val *float64,
+
+// types.go2:356
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpGreaterThan,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1float64) LessOrEqual(val *float64,
+
+// types.go2:367
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpLessOrEqual,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1float64) GreaterOrEqual(val *float64,
+
+// types.go2:378
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpGreaterOrEqual,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1float64) Between(min, max *float64,
+
+// types.go2:389
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpBetween,
+		Value:    []*float64{min, max},
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1float64) On(other struct {
+	// types.go2:400
+	instantiate୦୦Ordered୦୮1float64
+	// types.go2:400
+}) Linker {
+	return t.instantiate୦୦Type୦୮1float64.On(struct {
+		instantiate୦୦Type୦୮1float64
+	}{other.instantiate୦୦Type୦୮1float64})
+}
+
+// types.go2:338
+type instantiate୦୦Type୦୮1bool struct {
+	// types.go2:56
+	driver        Driver
+	table, column string
+	view          Table
+
+	offset uintptr
+
+	tag tag
+
+	value *bool
+
+	// types.go2:65
+	slice []*bool
+
+	master **bool
+}
+
+func (t instantiate୦୦Type୦୮1bool) Column() string {
+	return t.column
+}
+
+func (t instantiate୦୦Type୦୮1bool) Offset() uintptr {
+	return t.offset
+}
+
+func (t instantiate୦୦Type୦୮1bool) FieldName() string {
+	return t.column
+}
+
+func (t instantiate୦୦Type୦୮1bool) Database() Driver {
+	return t.driver
+}
+
+func (t instantiate୦୦Type୦୮1bool) Table() string {
+	return t.table
+}
+
+func (t *instantiate୦୦Type୦୮1bool) Master() bool {
+	return t.master == &t.value
+}
+
+func (t instantiate୦୦Type୦୮1bool) Key() bool {
+	return t.tag.key
+}
+
+func (t instantiate୦୦Type୦୮1bool) Indexed() bool {
+	return t.tag.index
+}
+
+func (t instantiate୦୦Type୦୮1bool) Auto() bool {
+	return t.tag.auto
+}
+
+func (t instantiate୦୦Type୦୮1bool) Unique() bool {
+	return t.tag.unique
+}
+
+func (t instantiate୦୦Type୦୮1bool) IndexName() string {
+	return t.tag.name
+}
+
+func (t instantiate୦୦Type୦୮1bool) References() string {
+	return t.tag.references
+}
+
+func (t instantiate୦୦Type୦୮1bool) OnDelete() string {
+	return t.tag.ondelete
+}
+
+func (t instantiate୦୦Type୦୮1bool) Precision() int {
+	return t.tag.precision
+}
+
+func (t instantiate୦୦Type୦୮1bool) Scale() int {
+	return t.tag.scale
+}
+
+func (t instantiate୦୦Type୦୮1bool) String() string {
+	return fmt.Sprint(deref(t.value))
+}
+
+func (t instantiate୦୦Type୦୮1bool) Increasing() Sorter {
+	return Sorter{
+		t.table,
+		t.column,
+		false,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1bool) Decreasing() Sorter {
+	return Sorter{
+		t.table,
+		t.column,
+		true,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1bool) Value() *bool {
+	return t.value
+}
+
+func (t instantiate୦୦Type୦୮1bool) Equals(val *bool,
+
+// types.go2:154
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpEquals,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1bool) NotEquals(val *bool,
+
+// types.go2:165
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotEquals,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1bool) In(values ...*bool,
+
+// types.go2:176
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1bool) NotIn(values ...*bool,
+
+// types.go2:187
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1bool) IsNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIsNull,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1bool) NotNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotNull,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1bool) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+
+func (t instantiate୦୦Type୦୮1bool) Interface() interface{} {
+	return t.value
+}
+
+func (t instantiate୦୦Type୦୮1bool) Type() reflect.Type {
+	return reflect.TypeOf([0]*bool{}).Elem()
+}
+
+func (t *instantiate୦୦Type୦୮1bool) Pointer() interface{} {
+	return &t.value
+}
+
+func (t *instantiate୦୦Type୦୮1bool) Make(length int) interface{} {
+	if len(t.slice) != length {
+		t.slice = make([]*bool, length)
+	}
+	return t.slice
+}
+
+func (t *instantiate୦୦Type୦୮1bool) Slice(index int) interface{} {
+	if index < len(t.slice) && index >= 0 {
+		return &t.slice[index]
+	}
+	return nil
+}
+
+func (t *instantiate୦୦Type୦୮1bool) Index(index int) bool {
+	if index < len(t.slice) && index >= 0 {
+		t.value = t.slice[index]
+		return true
+	}
+	return false
+}
+
+func (t *instantiate୦୦Type୦୮1bool) setprivate(
+	table, column string,
+	offset uintptr,
+	tag tag,
+	driver Driver,
+	view Table,
+) {
+
+	t.table = table
+	t.offset = offset
+	t.column = column
+	t.tag = tag
+	t.driver = driver
+	t.view = view
+}
+
+func (t *instantiate୦୦Type୦୮1bool) Set(val *bool,
+
+// types.go2:272
+) {
+	t.value = val
+}
+
+func (t instantiate୦୦Type୦୮1bool) To(val *bool,
+
+// types.go2:276
+) Update {
+	return Update{
+		Table:  t.table,
+		driver: t.driver,
+		Column: t.column,
+		Value:  val,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1bool) On(other struct {
+	instantiate୦୦Type୦୮1bool
+}) Linker {
+	return Linker{
+		From: t,
+		To:   other,
+		View: t.view,
+	}
+}
+
+// types.go2:294
+func (t instantiate୦୦Type୦୮1bool) Test(ctx *testing.T) {
+	var Testable struct {
+		View `db:"typeable"`
+
+		Value instantiate୦୦Type୦୮1bool
+	}
+
+	defer Open().Connect(&Testable).Close()
+
+	shouldNotError(
+		Sync(Testable),
+	).Test(ctx)
+
+	var zero *bool
+
+	// types.go2:311
+	var test = Testable
+	test.Value.Set(zero)
+
+	shouldNotError(
+		Insert(test),
+	).Test(ctx)
+
+	// types.go2:319
+	var result = Testable
+
+	shouldNotError(
+		If(Testable.Value.Equals(zero)).Get(&result),
+	).Test(ctx)
+
+	shouldBe(zero)(result.Value.Value()).Test(ctx)
+
+	// types.go2:328
+	shouldNotError(
+		If(Testable.Value.Equals(zero)).Update(
+			result.Value.To(zero),
+		),
+	).Test(ctx)
+
+	// types.go2:335
+	shouldNotError(
+		Delete(&Testable),
+	).Test(ctx)
+}
+
+// types.go2:338
+type instantiate୦୦Type୦୮1string struct {
+	// types.go2:56
+	driver        Driver
+	table, column string
+	view          Table
+
+	offset uintptr
+
+	tag tag
+
+	value *string
+
+	// types.go2:65
+	slice []*string
+
+	master **string
+}
+
+func (t instantiate୦୦Type୦୮1string) Column() string {
+	return t.column
+}
+
+func (t instantiate୦୦Type୦୮1string) Offset() uintptr {
+	return t.offset
+}
+
+func (t instantiate୦୦Type୦୮1string) FieldName() string {
+	return t.column
+}
+
+func (t instantiate୦୦Type୦୮1string) Database() Driver {
+	return t.driver
+}
+
+func (t instantiate୦୦Type୦୮1string) Table() string {
+	return t.table
+}
+
+func (t *instantiate୦୦Type୦୮1string) Master() bool {
+	return t.master == &t.value
+}
+
+func (t instantiate୦୦Type୦୮1string) Key() bool {
+	return t.tag.key
+}
+
+func (t instantiate୦୦Type୦୮1string) Indexed() bool {
+	return t.tag.index
+}
+
+func (t instantiate୦୦Type୦୮1string) Auto() bool {
+	return t.tag.auto
+}
+
+func (t instantiate୦୦Type୦୮1string) Unique() bool {
+	return t.tag.unique
+}
+
+func (t instantiate୦୦Type୦୮1string) IndexName() string {
+	return t.tag.name
+}
+
+func (t instantiate୦୦Type୦୮1string) References() string {
+	return t.tag.references
+}
+
+func (t instantiate୦୦Type୦୮1string) OnDelete() string {
+	return t.tag.ondelete
+}
+
+func (t instantiate୦୦Type୦୮1string) Precision() int {
+	return t.tag.precision
+}
+
+func (t instantiate୦୦Type୦୮1string) Scale() int {
+	return t.tag.scale
+}
+
+func (t instantiate୦୦Type୦୮1string) String() string {
+	return fmt.Sprint(deref(t.value))
+}
+
+func (t instantiate୦୦Type୦୮1string) Increasing() Sorter {
+	return Sorter{
+		t.table,
+		t.column,
+		false,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1string) Decreasing() Sorter {
+	return Sorter{
+		t.table,
+		t.column,
+		true,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1string) Value() *string {
+	return t.value
+}
+
+func (t instantiate୦୦Type୦୮1string) Equals(val *string,
+
+// types.go2:154
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpEquals,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1string) NotEquals(val *string,
+
+// types.go2:165
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotEquals,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1string) In(values ...*string,
+
+// types.go2:176
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1string) NotIn(values ...*string,
+
+// types.go2:187
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1string) IsNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIsNull,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1string) NotNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotNull,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1string) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+
+func (t instantiate୦୦Type୦୮1string) Interface() interface{} {
+	return t.value
+}
+
+func (t instantiate୦୦Type୦୮1string) Type() reflect.Type {
+	return reflect.TypeOf([0]*string{}).Elem()
+}
+
+func (t *instantiate୦୦Type୦୮1string) Pointer() interface{} {
+	return &t.value
+}
+
+func (t *instantiate୦୦Type୦୮1string) Make(length int) interface{} {
+	if len(t.slice) != length {
+		t.slice = make([]*string, length)
+	}
+	return t.slice
+}
+
+func (t *instantiate୦୦Type୦୮1string) Slice(index int) interface{} {
+	if index < len(t.slice) && index >= 0 {
+		return &t.slice[index]
+	}
+	return nil
+}
+
+func (t *instantiate୦୦Type୦୮1string) Index(index int) bool {
+	if index < len(t.slice) && index >= 0 {
+		t.value = t.slice[index]
+		return true
+	}
+	return false
+}
+
+func (t *instantiate୦୦Type୦୮1string) setprivate(
+	table, column string,
+	offset uintptr,
+	tag tag,
+	driver Driver,
+	view Table,
+) {
+
+	t.table = table
+	t.offset = offset
+	t.column = column
+	t.tag = tag
+	t.driver = driver
+	t.view = view
+}
+
+func (t *instantiate୦୦Type୦୮1string) Set(val *string,
+
+// types.go2:272
+) {
+	t.value = val
+}
+
+func (t instantiate୦୦Type୦୮1string) To(val *string,
+
+// types.go2:276
+) Update {
+	return Update{
+		Table:  t.table,
+		driver: t.driver,
+		Column: t.column,
+		Value:  val,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1string) On(other struct {
+	instantiate୦୦Type୦୮1string
+}) Linker {
+	return Linker{
+		From: t,
+		To:   other,
+		View: t.view,
+	}
+}
+
+// types.go2:294
+func (t instantiate୦୦Type୦୮1string) Test(ctx *testing.T) {
+	var Testable struct {
+		View `db:"typeable"`
+
+		Value instantiate୦୦Type୦୮1string
+	}
+
+	defer Open().Connect(&Testable).Close()
+
+	shouldNotError(
+		Sync(Testable),
+	).Test(ctx)
+
+	var zero *string
+
+	// types.go2:311
+	var test = Testable
+	test.Value.Set(zero)
+
+	shouldNotError(
+		Insert(test),
+	).Test(ctx)
+
+	// types.go2:319
+	var result = Testable
+
+	shouldNotError(
+		If(Testable.Value.Equals(zero)).Get(&result),
+	).Test(ctx)
+
+	shouldBe(zero)(result.Value.Value()).Test(ctx)
+
+	// types.go2:328
+	shouldNotError(
+		If(Testable.Value.Equals(zero)).Update(
+			result.Value.To(zero),
+		),
+	).Test(ctx)
+
+	// types.go2:335
+	shouldNotError(
+		Delete(&Testable),
+	).Test(ctx)
+}
+
+// types.go2:341
+type instantiate୦୦Ordered୦୮1string struct {
+	// types.go2:342
+	instantiate୦୦Type୦୮1string
+}
+
+func (t instantiate୦୦Ordered୦୮1string) LessThan(val *string,
+
+// types.go2:345
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpLessThan,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1string) GreaterThan(val *string,
+
+// types.go2:356
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpGreaterThan,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1string) LessOrEqual(val *string,
+
+// types.go2:367
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpLessOrEqual,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1string) GreaterOrEqual(val *string,
+
+// types.go2:378
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpGreaterOrEqual,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1string) Between(min, max *string,
+
+// types.go2:389
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpBetween,
+		Value:    []*string{min, max},
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1string) On(other struct {
+	// types.go2:400
+	instantiate୦୦Ordered୦୮1string
+	// types.go2:400
+}) Linker {
+	return t.instantiate୦୦Type୦୮1string.On(struct {
+		instantiate୦୦Type୦୮1string
+	}{other.instantiate୦୦Type୦୮1string})
+}
+
+// types.go2:338
+type instantiate୦୦Type୦୮1time୮aTime struct {
+	// types.go2:56
+	driver        Driver
+	table, column string
+	view          Table
+
+	offset uintptr
+
+	tag tag
+
+	value *time.Time
+
+	// types.go2:65
+	slice []*time.Time
+
+	master **time.Time
+}
+
+func (t instantiate୦୦Type୦୮1time୮aTime) Column() string {
+	return t.column
+}
+
+func (t instantiate୦୦Type୦୮1time୮aTime) Offset() uintptr {
+	return t.offset
+}
+
+func (t instantiate୦୦Type୦୮1time୮aTime) FieldName() string {
+	return t.column
+}
+
+func (t instantiate୦୦Type୦୮1time୮aTime) Database() Driver {
+	return t.driver
+}
+
+func (t instantiate୦୦Type୦୮1time୮aTime) Table() string {
+	return t.table
+}
+
+func (t *instantiate୦୦Type୦୮1time୮aTime) Master() bool {
+	return t.master == &t.value
+}
+
+func (t instantiate୦୦Type୦୮1time୮aTime) Key() bool {
+	return t.tag.key
+}
+
+func (t instantiate୦୦Type୦୮1time୮aTime) Indexed() bool {
+	return t.tag.index
+}
+
+func (t instantiate୦୦Type୦୮1time୮aTime) Auto() bool {
+	return t.tag.auto
+}
+
+func (t instantiate୦୦Type୦୮1time୮aTime) Unique() bool {
+	return t.tag.unique
+}
+
+func (t instantiate୦୦Type୦୮1time୮aTime) IndexName() string {
+	return t.tag.name
+}
+
+func (t instantiate୦୦Type୦୮1time୮aTime) References() string {
+	return t.tag.references
+}
+
+func (t instantiate୦୦Type୦୮1time୮aTime) OnDelete() string {
+	return t.tag.ondelete
+}
+
+func (t instantiate୦୦Type୦୮1time୮aTime) Precision() int {
+	return t.tag.precision
+}
+
+func (t instantiate୦୦Type୦୮1time୮aTime) Scale() int {
+	return t.tag.scale
+}
+
+func (t instantiate୦୦Type୦୮1time୮aTime) String() string {
+	return fmt.Sprint(deref(t.value))
+}
+
+func (t instantiate୦୦Type୦୮1time୮aTime) Increasing() Sorter {
+	return Sorter{
+		t.table,
+		t.column,
+		false,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1time୮aTime) Decreasing() Sorter {
+	return Sorter{
+		t.table,
+		t.column,
+		true,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1time୮aTime) Value() *time.Time {
+	return t.value
+}
+
+func (t instantiate୦୦Type୦୮1time୮aTime) Equals(val *time.Time,
+
+// types.go2:154
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpEquals,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1time୮aTime) NotEquals(val *time.Time,
+
+// types.go2:165
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotEquals,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1time୮aTime) In(values ...*time.Time,
+
+// types.go2:176
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1time୮aTime) NotIn(values ...*time.Time,
+
+// types.go2:187
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1time୮aTime) IsNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIsNull,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1time୮aTime) NotNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotNull,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1time୮aTime) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+
+func (t instantiate୦୦Type୦୮1time୮aTime) Interface() interface{} {
+	return t.value
+}
+
+func (t instantiate୦୦Type୦୮1time୮aTime) Type() reflect.Type {
+	return reflect.TypeOf([0]*time.Time{}).Elem()
+}
+
+func (t *instantiate୦୦Type୦୮1time୮aTime) Pointer() interface{} {
+	return &t.value
+}
+
+func (t *instantiate୦୦Type୦୮1time୮aTime) Make(length int) interface{} {
+	if len(t.slice) != length {
+		t.slice = make([]*time.Time, length)
+	}
+	return t.slice
+}
+
+func (t *instantiate୦୦Type୦୮1time୮aTime) Slice(index int) interface{} {
+	if index < len(t.slice) && index >= 0 {
+		return &t.slice[index]
+	}
+	return nil
+}
+
+func (t *instantiate୦୦Type୦୮1time୮aTime) Index(index int) bool {
+	if index < len(t.slice) && index >= 0 {
+		t.value = t.slice[index]
+		return true
+	}
+	return false
+}
+
+func (t *instantiate୦୦Type୦୮1time୮aTime) setprivate(
+	table, column string,
+	offset uintptr,
+	tag tag,
+	driver Driver,
+	view Table,
+) {
+
+	t.table = table
+	t.offset = offset
+	t.column = column
+	t.tag = tag
+	t.driver = driver
+	t.view = view
+}
+
+func (t *instantiate୦୦Type୦୮1time୮aTime) Set(val *time.Time,
+
+// types.go2:272
+) {
+	t.value = val
+}
+
+func (t instantiate୦୦Type୦୮1time୮aTime) To(val *time.Time,
+
+// types.go2:276
+) Update {
+	return Update{
+		Table:  t.table,
+		driver: t.driver,
+		Column: t.column,
+		Value:  val,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1time୮aTime) On(other struct {
+	instantiate୦୦Type୦୮1time୮aTime
+}) Linker {
+	return Linker{
+		From: t,
+		To:   other,
+		View: t.view,
+	}
+}
+
+// types.go2:294
+func (t instantiate୦୦Type୦୮1time୮aTime) Test(ctx *testing.T) {
+	var Testable struct {
+		View `db:"typeable"`
+
+		Value instantiate୦୦Type୦୮1time୮aTime
+	}
+
+	defer Open().Connect(&Testable).Close()
+
+	shouldNotError(
+		Sync(Testable),
+	).Test(ctx)
+
+	var zero *time.Time
+
+	// types.go2:311
+	var test = Testable
+	test.Value.Set(zero)
+
+	shouldNotError(
+		Insert(test),
+	).Test(ctx)
+
+	// types.go2:319
+	var result = Testable
+
+	shouldNotError(
+		If(Testable.Value.Equals(zero)).Get(&result),
+	).Test(ctx)
+
+	shouldBe(zero)(result.Value.Value()).Test(ctx)
+
+	// types.go2:328
+	shouldNotError(
+		If(Testable.Value.Equals(zero)).Update(
+			result.Value.To(zero),
+		),
+	).Test(ctx)
+
+	// types.go2:335
+	shouldNotError(
+		Delete(&Testable),
+	).Test(ctx)
+}
+
+// types.go2:341
+type instantiate୦୦Ordered୦୮1time୮aTime struct {
+	// types.go2:342
+	instantiate୦୦Type୦୮1time୮aTime
+}
+
+func (t instantiate୦୦Ordered୦୮1time୮aTime) LessThan(val *time.Time,
+
+// types.go2:345
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpLessThan,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1time୮aTime) GreaterThan(val *time.Time,
+
+// types.go2:356
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpGreaterThan,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1time୮aTime) LessOrEqual(val *time.Time,
+
+// types.go2:367
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpLessOrEqual,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1time୮aTime) GreaterOrEqual(val *time.Time,
+
+// types.go2:378
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpGreaterOrEqual,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1time୮aTime) Between(min, max *time.Time,
+
+// types.go2:389
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpBetween,
+		Value:    []*time.Time{min, max},
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1time୮aTime) On(other struct {
+	// types.go2:400
+	instantiate୦୦Ordered୦୮1time୮aTime
+	// types.go2:400
+}) Linker {
+	return t.instantiate୦୦Type୦୮1time୮aTime.On(struct {
+		instantiate୦୦Type୦୮1time୮aTime
+	}{other.instantiate୦୦Type୦୮1time୮aTime})
+}
+
+// types.go2:338
+type instantiate୦୦Type୦୮1db୮auid struct {
+	// types.go2:56
+	driver        Driver
+	table, column string
+	view          Table
+
+	offset uintptr
+
+	tag tag
+
+	value *uid
+
+	// types.go2:65
+	slice []*uid
+
+	master **uid
+}
+
+func (t instantiate୦୦Type୦୮1db୮auid) Column() string {
+	return t.column
+}
+
+func (t instantiate୦୦Type୦୮1db୮auid) Offset() uintptr {
+	return t.offset
+}
+
+func (t instantiate୦୦Type୦୮1db୮auid) FieldName() string {
+	return t.column
+}
+
+func (t instantiate୦୦Type୦୮1db୮auid) Database() Driver {
+	return t.driver
+}
+
+func (t instantiate୦୦Type୦୮1db୮auid) Table() string {
+	return t.table
+}
+
+func (t *instantiate୦୦Type୦୮1db୮auid) Master() bool {
+	return t.master == &t.value
+}
+
+func (t instantiate୦୦Type୦୮1db୮auid) Key() bool {
+	return t.tag.key
+}
+
+func (t instantiate୦୦Type୦୮1db୮auid) Indexed() bool {
+	return t.tag.index
+}
+
+func (t instantiate୦୦Type୦୮1db୮auid) Auto() bool {
+	return t.tag.auto
+}
+
+func (t instantiate୦୦Type୦୮1db୮auid) Unique() bool {
+	return t.tag.unique
+}
+
+func (t instantiate୦୦Type୦୮1db୮auid) IndexName() string {
+	return t.tag.name
+}
+
+func (t instantiate୦୦Type୦୮1db୮auid) References() string {
+	return t.tag.references
+}
+
+func (t instantiate୦୦Type୦୮1db୮auid) OnDelete() string {
+	return t.tag.ondelete
+}
+
+func (t instantiate୦୦Type୦୮1db୮auid) Precision() int {
+	return t.tag.precision
+}
+
+func (t instantiate୦୦Type୦୮1db୮auid) Scale() int {
+	return t.tag.scale
+}
+
+func (t instantiate୦୦Type୦୮1db୮auid) String() string {
+	return fmt.Sprint(deref(t.value))
+}
+
+func (t instantiate୦୦Type୦୮1db୮auid) Increasing() Sorter {
+	return Sorter{
+		t.table,
+		t.column,
+		false,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1db୮auid) Decreasing() Sorter {
+	return Sorter{
+		t.table,
+		t.column,
+		true,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1db୮auid) Value() *uid {
+	return t.value
+}
+
+func (t instantiate୦୦Type୦୮1db୮auid) Equals(val *uid,
+
+// types.go2:154
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpEquals,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1db୮auid) NotEquals(val *uid,
+
+// types.go2:165
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotEquals,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1db୮auid) In(values ...*uid,
+
+// types.go2:176
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1db୮auid) NotIn(values ...*uid,
+
+// types.go2:187
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1db୮auid) IsNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIsNull,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1db୮auid) NotNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotNull,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1db୮auid) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+
+func (t instantiate୦୦Type୦୮1db୮auid) Interface() interface{} {
+	return t.value
+}
+
+func (t instantiate୦୦Type୦୮1db୮auid) Type() reflect.Type {
+	return reflect.TypeOf([0]*uid{}).Elem()
+}
+
+func (t *instantiate୦୦Type୦୮1db୮auid) Pointer() interface{} {
+	return &t.value
+}
+
+func (t *instantiate୦୦Type୦୮1db୮auid) Make(length int) interface{} {
+	if len(t.slice) != length {
+		t.slice = make([]*uid, length)
+	}
+	return t.slice
+}
+
+func (t *instantiate୦୦Type୦୮1db୮auid) Slice(index int) interface{} {
+	if index < len(t.slice) && index >= 0 {
+		return &t.slice[index]
+	}
+	return nil
+}
+
+func (t *instantiate୦୦Type୦୮1db୮auid) Index(index int) bool {
+	if index < len(t.slice) && index >= 0 {
+		t.value = t.slice[index]
+		return true
+	}
+	return false
+}
+
+func (t *instantiate୦୦Type୦୮1db୮auid) setprivate(
+	table, column string,
+	offset uintptr,
+	tag tag,
+	driver Driver,
+	view Table,
+) {
+
+	t.table = table
+	t.offset = offset
+	t.column = column
+	t.tag = tag
+	t.driver = driver
+	t.view = view
+}
+
+func (t *instantiate୦୦Type୦୮1db୮auid) Set(val *uid,
+
+// types.go2:272
+) {
+	t.value = val
+}
+
+func (t instantiate୦୦Type୦୮1db୮auid) To(val *uid,
+
+// types.go2:276
+) Update {
+	return Update{
+		Table:  t.table,
+		driver: t.driver,
+		Column: t.column,
+		Value:  val,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1db୮auid) On(other struct {
+	instantiate୦୦Type୦୮1db୮auid
+}) Linker {
+	return Linker{
+		From: t,
+		To:   other,
+		View: t.view,
+	}
+}
+
+// types.go2:294
+func (t instantiate୦୦Type୦୮1db୮auid) Test(ctx *testing.T) {
+	var Testable struct {
+		View `db:"typeable"`
+
+		Value instantiate୦୦Type୦୮1db୮auid
+	}
+
+	defer Open().Connect(&Testable).Close()
+
+	shouldNotError(
+		Sync(Testable),
+	).Test(ctx)
+
+	var zero *uid
+
+	// types.go2:311
+	var test = Testable
+	test.Value.Set(zero)
+
+	shouldNotError(
+		Insert(test),
+	).Test(ctx)
+
+	// types.go2:319
+	var result = Testable
+
+	shouldNotError(
+		If(Testable.Value.Equals(zero)).Get(&result),
+	).Test(ctx)
+
+	shouldBe(zero)(result.Value.Value()).Test(ctx)
+
+	// types.go2:328
+	shouldNotError(
+		If(Testable.Value.Equals(zero)).Update(
+			result.Value.To(zero),
+		),
+	).Test(ctx)
+
+	// types.go2:335
+	shouldNotError(
+		Delete(&Testable),
+	).Test(ctx)
+}
+
+// types.go2:341
+type instantiate୦୦Ordered୦୮1db୮auid struct {
+	// types.go2:342
+	instantiate୦୦Type୦୮1db୮auid
+}
+
+func (t instantiate୦୦Ordered୦୮1db୮auid) LessThan(val *uid,
+
+// types.go2:345
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpLessThan,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1db୮auid) GreaterThan(val *uid,
+
+// types.go2:356
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpGreaterThan,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1db୮auid) LessOrEqual(val *uid,
+
+// types.go2:367
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpLessOrEqual,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1db୮auid) GreaterOrEqual(val *uid,
+
+// types.go2:378
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpGreaterOrEqual,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1db୮auid) Between(min, max *uid,
+
+// types.go2:389
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpBetween,
+		Value:    []*uid{min, max},
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1db୮auid) On(other struct {
+	// types.go2:400
+	instantiate୦୦Ordered୦୮1db୮auid
+	// types.go2:400
+}) Linker {
+	return t.instantiate୦୦Type୦୮1db୮auid.On(struct {
+		instantiate୦୦Type୦୮1db୮auid
+	}{other.instantiate୦୦Type୦୮1db୮auid})
+}
+
+// types.go2:338
+type instantiate୦୦Type୦db୮aDec struct {
+	// types.go2:56
+	driver        Driver
+	table, column string
+	view          Table
+
+	offset uintptr
+
+	tag tag
+
+	value Dec
+
+	// types.go2:65
+	slice []Dec
+
+	master *Dec
+}
+
+func (t instantiate୦୦Type୦db୮aDec) Column() string {
+	return t.column
+}
+
+func (t instantiate୦୦Type୦db୮aDec) Offset() uintptr {
+	return t.offset
+}
+
+func (t instantiate୦୦Type୦db୮aDec) FieldName() string {
+	return t.column
+}
+
+func (t instantiate୦୦Type୦db୮aDec) Database() Driver {
+	return t.driver
+}
+
+func (t instantiate୦୦Type୦db୮aDec) Table() string {
+	return t.table
+}
+
+func (t *instantiate୦୦Type୦db୮aDec) Master() bool {
+	return t.master == &t.value
+}
+
+func (t instantiate୦୦Type୦db୮aDec) Key() bool {
+	return t.tag.key
+}
+
+func (t instantiate୦୦Type୦db୮aDec) Indexed() bool {
+	return t.tag.index
+}
+
+func (t instantiate୦୦Type୦db୮aDec) Auto() bool {
+	return t.tag.auto
+}
+
+func (t instantiate୦୦Type୦db୮aDec) Unique() bool {
+	return t.tag.unique
+}
+
+func (t instantiate୦୦Type୦db୮aDec) IndexName() string {
+	return t.tag.name
+}
+
+func (t instantiate୦୦Type୦db୮aDec) References() string {
+	return t.tag.references
+}
+
+func (t instantiate୦୦Type୦db୮aDec) OnDelete() string {
+	return t.tag.ondelete
+}
+
+func (t instantiate୦୦Type୦db୮aDec) Precision() int {
+	return t.tag.precision
+}
+
+func (t instantiate୦୦Type୦db୮aDec) Scale() int {
+	return t.tag.scale
+}
+
+func (t instantiate୦୦Type୦db୮aDec) String() string {
+	return fmt.Sprint(deref(t.value))
+}
+
+func (t instantiate୦୦Type୦db୮aDec) Increasing() Sorter {
+	return Sorter{
+		t.table,
+		t.column,
+		false,
+	}
+}
+
+func (t instantiate୦୦Type୦db୮aDec) Decreasing() Sorter {
+	return Sorter{
+		t.table,
+		t.column,
+		true,
+	}
+}
+
+func (t instantiate୦୦Type୦db୮aDec) Value() Dec {
+	return t.value
+}
+
+func (t instantiate୦୦Type୦db୮aDec) Equals(val Dec,
+
+// types.go2:154
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpEquals,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Type୦db୮aDec) NotEquals(val Dec,
+
+// types.go2:165
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotEquals,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Type୦db୮aDec) In(values ...Dec,
+
+// types.go2:176
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦db୮aDec) NotIn(values ...Dec,
+
+// types.go2:187
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦db୮aDec) IsNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIsNull,
+	}
+}
+
+func (t instantiate୦୦Type୦db୮aDec) NotNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotNull,
+	}
+}
+
+func (t instantiate୦୦Type୦db୮aDec) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+
+func (t instantiate୦୦Type୦db୮aDec) Interface() interface{} {
+	return t.value
+}
+
+func (t instantiate୦୦Type୦db୮aDec) Type() reflect.Type {
+	return reflect.TypeOf([0]Dec{}).Elem()
+}
+
+func (t *instantiate୦୦Type୦db୮aDec) Pointer() interface{} {
+	return &t.value
+}
+
+func (t *instantiate୦୦Type୦db୮aDec) Make(length int) interface{} {
+	if len(t.slice) != length {
+		t.slice = make([]Dec, length)
+	}
+	return t.slice
+}
+
+func (t *instantiate୦୦Type୦db୮aDec) Slice(index int) interface{} {
+	if index < len(t.slice) && index >= 0 {
+		return &t.slice[index]
+	}
+	return nil
+}
+
+func (t *instantiate୦୦Type୦db୮aDec) Index(index int) bool {
+	if index < len(t.slice) && index >= 0 {
+		t.value = t.slice[index]
+		return true
+	}
+	return false
+}
+
+func (t *instantiate୦୦Type୦db୮aDec) setprivate(
+	table, column string,
+	offset uintptr,
+	tag tag,
+	driver Driver,
+	view Table,
+) {
+
+	t.table = table
+	t.offset = offset
+	t.column = column
+	t.tag = tag
+	t.driver = driver
+	t.view = view
+}
+
+func (t *instantiate୦୦Type୦db୮aDec) Set(val Dec,
+
+// types.go2:272
+) {
+	t.value = val
+}
+
+func (t instantiate୦୦Type୦db୮aDec) To(val Dec,
+
+// types.go2:276
+) Update {
+	return Update{
+		Table:  t.table,
+		driver: t.driver,
+		Column: t.column,
+		Value:  val,
+	}
+}
+
+func (t instantiate୦୦Type୦db୮aDec) On(other struct {
+	instantiate୦୦Type୦db୮aDec
+}) Linker {
+	return Linker{
+		From: t,
+		To:   other,
+		View: t.view,
+	}
+}
+
+// types.go2:294
+func (t instantiate୦୦Type୦db୮aDec) Test(ctx *testing.T) {
+	var Testable struct {
+		View `db:"typeable"`
+
+		Value instantiate୦୦Type୦db୮aDec
+	}
+
+	defer Open().Connect(&Testable).Close()
+
+	shouldNotError(
+		Sync(Testable),
+	).Test(ctx)
+
+	var zero Dec
+
+	// types.go2:311
+	var test = Testable
+	test.Value.Set(zero)
+
+	shouldNotError(
+		Insert(test),
+	).Test(ctx)
+
+	// types.go2:319
+	var result = Testable
+
+	shouldNotError(
+		If(Testable.Value.Equals(zero)).Get(&result),
+	).Test(ctx)
+
+	shouldBe(zero)(result.Value.Value()).Test(ctx)
+
+	// types.go2:328
+	shouldNotError(
+		If(Testable.Value.Equals(zero)).Update(
+			result.Value.To(zero),
+		),
+	).Test(ctx)
+
+	// types.go2:335
+	shouldNotError(
+		Delete(&Testable),
+	).Test(ctx)
+}
+
+// types.go2:341
+type instantiate୦୦Ordered୦db୮aDec struct {
+	// types.go2:342
+	instantiate୦୦Type୦db୮aDec
+}
+
+func (t instantiate୦୦Ordered୦db୮aDec) LessThan(val Dec,
+
+// types.go2:345
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpLessThan,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦db୮aDec) GreaterThan(val Dec,
+
+// types.go2:356
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpGreaterThan,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦db୮aDec) LessOrEqual(val Dec,
+
+// types.go2:367
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpLessOrEqual,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦db୮aDec) GreaterOrEqual(val Dec,
+
+// types.go2:378
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpGreaterOrEqual,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦db୮aDec) Between(min, max Dec,
+
+// types.go2:389
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpBetween,
+		Value:    []Dec{min, max},
+	}
+}
+
+func (t instantiate୦୦Ordered୦db୮aDec) On(other struct {
+	// types.go2:400
+	instantiate୦୦Ordered୦db୮aDec
+	// types.go2:400
+}) Linker {
+	return t.instantiate୦୦Type୦db୮aDec.On(struct {
+		instantiate୦୦Type୦db୮aDec
+	}{other.instantiate୦୦Type୦db୮aDec})
+}
+
+// types.go2:338
+type instantiate୦୦Type୦୮1db୮aDec struct {
+	// types.go2:56
+	driver        Driver
+	table, column string
+	view          Table
+
+	offset uintptr
+
+	tag tag
+
+	value *Dec
+
+	// types.go2:65
+	slice []*Dec
+
+	master **Dec
+}
+
+func (t instantiate୦୦Type୦୮1db୮aDec) Column() string {
+	return t.column
+}
+
+func (t instantiate୦୦Type୦୮1db୮aDec) Offset() uintptr {
+	return t.offset
+}
+
+func (t instantiate୦୦Type୦୮1db୮aDec) FieldName() string {
+	return t.column
+}
+
+func (t instantiate୦୦Type୦୮1db୮aDec) Database() Driver {
+	return t.driver
+}
+
+func (t instantiate୦୦Type୦୮1db୮aDec) Table() string {
+	return t.table
+}
+
+func (t *instantiate୦୦Type୦୮1db୮aDec) Master() bool {
+	return t.master == &t.value
+}
+
+func (t instantiate୦୦Type୦୮1db୮aDec) Key() bool {
+	return t.tag.key
+}
+
+func (t instantiate୦୦Type୦୮1db୮aDec) Indexed() bool {
+	return t.tag.index
+}
+
+func (t instantiate୦୦Type୦୮1db୮aDec) Auto() bool {
+	return t.tag.auto
+}
+
+func (t instantiate୦୦Type୦୮1db୮aDec) Unique() bool {
+	return t.tag.unique
+}
+
+func (t instantiate୦୦Type୦୮1db୮aDec) IndexName() string {
+	return t.tag.name
+}
+
+func (t instantiate୦୦Type୦୮1db୮aDec) References() string {
+	return t.tag.references
+}
+
+func (t instantiate୦୦Type୦୮1db୮aDec) OnDelete() string {
+	return t.tag.ondelete
+}
+
+func (t instantiate୦୦Type୦୮1db୮aDec) Precision() int {
+	return t.tag.precision
+}
+
+func (t instantiate୦୦Type୦୮1db୮aDec) Scale() int {
+	return t.tag.scale
+}
+
+func (t instantiate୦୦Type୦୮1db୮aDec) String() string {
+	return fmt.Sprint(deref(t.value))
+}
+
+func (t instantiate୦୦Type୦୮1db୮aDec) Increasing() Sorter {
+	return Sorter{
+		t.table,
+		t.column,
+		false,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1db୮aDec) Decreasing() Sorter {
+	return Sorter{
+		t.table,
+		t.column,
+		true,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1db୮aDec) Value() *Dec {
+	return t.value
+}
+
+func (t instantiate୦୦Type୦୮1db୮aDec) Equals(val *Dec,
+
+// types.go2:154
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpEquals,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1db୮aDec) NotEquals(val *Dec,
+
+// types.go2:165
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotEquals,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1db୮aDec) In(values ...*Dec,
+
+// types.go2:176
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1db୮aDec) NotIn(values ...*Dec,
+
+// types.go2:187
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1db୮aDec) IsNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIsNull,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1db୮aDec) NotNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotNull,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1db୮aDec) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+
+func (t instantiate୦୦Type୦୮1db୮aDec) Interface() interface{} {
+	return t.value
+}
+
+func (t instantiate୦୦Type୦୮1db୮aDec) Type() reflect.Type {
+	return reflect.TypeOf([0]*Dec{}).Elem()
+}
+
+func (t *instantiate୦୦Type୦୮1db୮aDec) Pointer() interface{} {
+	return &t.value
+}
+
+func (t *instantiate୦୦Type୦୮1db୮aDec) Make(length int) interface{} {
+	if len(t.slice) != length {
+		t.slice = make([]*Dec, length)
+	}
+	return t.slice
+}
+
+func (t *instantiate୦୦Type୦୮1db୮aDec) Slice(index int) interface{} {
+	if index < len(t.slice) && index >= 0 {
+		return &t.slice[index]
+	}
+	return nil
+}
+
+func (t *instantiate୦୦Type୦୮1db୮aDec) Index(index int) bool {
+	if index < len(t.slice) && index >= 0 {
+		t.value = t.slice[index]
+		return true
+	}
+	return false
+}
+
+func (t *instantiate୦୦Type୦୮1db୮aDec) setprivate(
+	table, column string,
+	offset uintptr,
+	tag tag,
+	driver Driver,
+	view Table,
+) {
+
+	t.table = table
+	t.offset = offset
+	t.column = column
+	t.tag = tag
+	t.driver = driver
+	t.view = view
+}
+
+func (t *instantiate୦୦Type୦୮1db୮aDec) Set(val *Dec,
+
+// types.go2:272
+) {
+	t.value = val
+}
+
+func (t instantiate୦୦Type୦୮1db୮aDec) To(val *Dec,
+
+// types.go2:276
+) Update {
+	return Update{
+		Table:  t.table,
+		driver: t.driver,
+		Column: t.column,
+		Value:  val,
+	}
+}
+
+func (t instantiate୦୦Type୦୮1db୮aDec) On(other struct {
+	instantiate୦୦Type୦୮1db୮aDec
+}) Linker {
+	return Linker{
+		From: t,
+		To:   other,
+		View: t.view,
+	}
+}
+
+// types.go2:294
+func (t instantiate୦୦Type୦୮1db୮aDec) Test(ctx *testing.T) {
+	var Testable struct {
+		View `db:"typeable"`
+
+		Value instantiate୦୦Type୦୮1db୮aDec
+	}
+
+	defer Open().Connect(&Testable).Close()
+
+	shouldNotError(
+		Sync(Testable),
+	).Test(ctx)
+
+	var zero *Dec
+
+	// types.go2:311
+	var test = Testable
+	test.Value.Set(zero)
+
+	shouldNotError(
+		Insert(test),
+	).Test(ctx)
+
+	// types.go2:319
+	var result = Testable
+
+	shouldNotError(
+		If(Testable.Value.Equals(zero)).Get(&result),
+	).Test(ctx)
+
+	shouldBe(zero)(result.Value.Value()).Test(ctx)
+
+	// types.go2:328
+	shouldNotError(
+		If(Testable.Value.Equals(zero)).Update(
+			result.Value.To(zero),
+		),
+	).Test(ctx)
+
+	// types.go2:335
+	shouldNotError(
+		Delete(&Testable),
+	).Test(ctx)
+}
+
+// types.go2:341
+type instantiate୦୦Ordered୦୮1db୮aDec struct {
+	// types.go2:342
+	instantiate୦୦Type୦୮1db୮aDec
+}
+
+func (t instantiate୦୦Ordered୦୮1db୮aDec) LessThan(val *Dec,
+
+// types.go2:345
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpLessThan,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1db୮aDec) GreaterThan(val *Dec,
+
+// types.go2:356
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpGreaterThan,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1db୮aDec) LessOrEqual(val *Dec,
+
+// types.go2:367
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpLessOrEqual,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1db୮aDec) GreaterOrEqual(val *Dec,
+
+// types.go2:378
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpGreaterOrEqual,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1db୮aDec) Between(min, max *Dec,
+
+// types.go2:389
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpBetween,
+		Value:    []*Dec{min, max},
+	}
+}
+
+func (t instantiate୦୦Ordered୦୮1db୮aDec) On(other struct {
+	// types.go2:400
+	instantiate୦୦Ordered୦୮1db୮aDec
+	// types.go2:400
+}) Linker {
+	return t.instantiate୦୦Type୦୮1db୮aDec.On(struct {
+		instantiate୦୦Type୦୮1db୮aDec
+	}{other.instantiate୦୦Type୦୮1db୮aDec})
+}
+
+// types.go2:338
+type instantiate୦୦Type୦db୮aDocument struct {
+	// types.go2:56
+	driver        Driver
+	table, column string
+	view          Table
+
+	offset uintptr
+
+	tag tag
+
+	value Document
+
+	// types.go2:65
+	slice []Document
+
+	master *Document
+}
+
+func (t instantiate୦୦Type୦db୮aDocument) Column() string {
+	return t.column
+}
+
+func (t instantiate୦୦Type୦db୮aDocument) Offset() uintptr {
+	return t.offset
+}
+
+func (t instantiate୦୦Type୦db୮aDocument) FieldName() string {
+	return t.column
+}
+
+func (t instantiate୦୦Type୦db୮aDocument) Database() Driver {
+	return t.driver
+}
+
+func (t instantiate୦୦Type୦db୮aDocument) Table() string {
+	return t.table
+}
+
+func (t *instantiate୦୦Type୦db୮aDocument) Master() bool {
+	return t.master == &t.value
+}
+
+func (t instantiate୦୦Type୦db୮aDocument) Key() bool {
+	return t.tag.key
+}
+
+func (t instantiate୦୦Type୦db୮aDocument) Indexed() bool {
+	return t.tag.index
+}
+
+func (t instantiate୦୦Type୦db୮aDocument) Auto() bool {
+	return t.tag.auto
+}
+
+func (t instantiate୦୦Type୦db୮aDocument) Unique() bool {
+	return t.tag.unique
+}
+
+func (t instantiate୦୦Type୦db୮aDocument) IndexName() string {
+	return t.tag.name
+}
+
+func (t instantiate୦୦Type୦db୮aDocument) References() string {
+	return t.tag.references
+}
+
+func (t instantiate୦୦Type୦db୮aDocument) OnDelete() string {
+	return t.tag.ondelete
+}
+
+func (t instantiate୦୦Type୦db୮aDocument) Precision() int {
+	return t.tag.precision
+}
+
+func (t instantiate୦୦Type୦db୮aDocument) Scale() int {
+	return t.tag.scale
+}
+
+func (t instantiate୦୦Type୦db୮aDocument) String() string {
+	return fmt.Sprint(deref(t.value))
+}
+
+func (t instantiate୦୦Type୦db୮aDocument) Increasing() Sorter {
+	return Sorter{
+		t.table,
+		t.column,
+		false,
+	}
+}
+
+func (t instantiate୦୦Type୦db୮aDocument) Decreasing() Sorter {
+	return Sorter{
+		t.table,
+		t.column,
+		true,
+	}
+}
+
+func (t instantiate୦୦Type୦db୮aDocument) Value() Document {
+	return t.value
+}
+
+func (t instantiate୦୦Type୦db୮aDocument) Equals(val Document,
+
+// types.go2:154
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpEquals,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Type୦db୮aDocument) NotEquals(val Document,
+
+// types.go2:165
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotEquals,
+		Value:    val,
+	}
+}
+
+func (t instantiate୦୦Type୦db୮aDocument) In(values ...Document,
+
+// types.go2:176
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦db୮aDocument) NotIn(values ...Document,
+
+// types.go2:187
+) Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotIn,
+		Value:    values,
+	}
+}
+
+func (t instantiate୦୦Type୦db୮aDocument) IsNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpIsNull,
+	}
+}
+
+func (t instantiate୦୦Type୦db୮aDocument) NotNull() Condition {
+	return Condition{
+		Table:    t.table,
+		View:     t.view,
+		driver:   t.driver,
+		Column:   t.column,
+		Operator: OpNotNull,
+	}
+}
+
+func (t instantiate୦୦Type୦db୮aDocument) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+
+func (t instantiate୦୦Type୦db୮aDocument) Interface() interface{} {
+	return t.value
+}
+
+func (t instantiate୦୦Type୦db୮aDocument) Type() reflect.Type {
+	return reflect.TypeOf([0]Document{}).Elem()
+}
+
+func (t *instantiate୦୦Type୦db୮aDocument) Pointer() interface{} {
+	return &t.value
+}
+
+func (t *instantiate୦୦Type୦db୮aDocument) Make(length int) interface{} {
+	if len(t.slice) != length {
+		t.slice = make([]Document, length)
+	}
+	return t.slice
+}
+
+func (t *instantiate୦୦Type୦db୮aDocument) Slice(index int) interface{} {
+	if index < len(t.slice) && index >= 0 {
+		return &t.slice[index]
+	}
+	return nil
+}
+
+func (t *instantiate୦୦Type୦db୮aDocument) Index(index int) bool {
+	if index < len(t.slice) && index >= 0 {
+		t.value = t.slice[index]
+		return true
+	}
+	return false
+}
+
+func (t *instantiate୦୦Type୦db୮aDocument) setprivate(
+	table, column string,
+	offset uintptr,
+	tag tag,
+	driver Driver,
+	view Table,
+) {
+
+	t.table = table
+	t.offset = offset
+	t.column = column
+	t.tag = tag
+	t.driver = driver
+	t.view = view
+}
+
+func (t *instantiate୦୦Type୦db୮aDocument) Set(val Document,
+
+// types.go2:272
+) {
+	t.value = val
+}
+
+func (t instantiate୦୦Type୦db୮aDocument) To(val Document,
+
+// types.go2:276
+) Update {
+	return Update{
+		Table:  t.table,
+		driver: t.driver,
+		Column: t.column,
+		Value:  val,
+	}
+}
+
+func (t instantiate୦୦Type୦db୮aDocument) On(other struct {
+	instantiate୦୦Type୦db୮aDocument
+}) Linker {
+	return Linker{
+		From: t,
+		To:   other,
+		View: t.view,
+	}
+}
+
+// types.go2:294
+func (t instantiate୦୦Type୦db୮aDocument) Test(ctx *testing.T) {
+	var Testable struct {
+		View `db:"typeable"`
+
+		Value instantiate୦୦Type୦db୮aDocument
+	}
+
+	defer Open().Connect(&Testable).Close()
+
+	shouldNotError(
+		Sync(Testable),
+	).Test(ctx)
+
+	var zero Document
+
+	// types.go2:311
 	var test = Testable
 	test.Value.Set(zero)
 
@@ -2314,7 +8432,7 @@ func (t instantiate୦୦Type୦db୮auid) Test(ctx *testing.T) {
 		Insert(test),
 	).Test(ctx)
 
-	// types.go2:223
+	// types.go2:319
 	var result = Testable
 
 	shouldNotError(
@@ -2323,33 +8441,33 @@ func (t instantiate୦୦Type୦db୮auid) Test(ctx *testing.T) {
 
 	shouldBe(zero)(result.Value.Value()).Test(ctx)
 
-	// types.go2:232
+	// types.go2:328
 	shouldNotError(
 		If(Testable.Value.Equals(zero)).Update(
 			result.Value.To(zero),
 		),
 	).Test(ctx)
 
-	// types.go2:239
+	// types.go2:335
 	shouldNotError(
 		Delete(&Testable),
 	).Test(ctx)
 }
 
-// types.go2:242
+// types.go2:338
 type Importable୦ int
 
-// types.go2:242
+// types.go2:338
 var _ = json.Compact
 
-// types.go2:242
+// types.go2:338
 var _ = fmt.Errorf
 
-// types.go2:242
+// types.go2:338
 var _ = reflect.Append
 
-// types.go2:242
+// types.go2:338
 var _ = testing.AllocsPerRun
 
-// types.go2:242
+// types.go2:338
 const _ = time.ANSIC
@@ -139,7 +139,7 @@ type value interface {
 	setprivate(
 		table, column string,
 		offset uintptr,
-		key bool,
+		tag tag,
 		driver Driver,
 		view Table,
 	)
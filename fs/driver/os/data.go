@@ -9,6 +9,7 @@ import (
 )
 
 var _ fs.Data = data{}
+var _ fs.Renamer = data{}
 
 //data implements fs.Data
 type data struct {
@@ -52,6 +53,11 @@ func (d data) ReadFrom(reader io.Reader) (int64, error) {
 	return io.Copy(f, reader)
 }
 
+//Rename implements fs.Renamer
+func (d data) Rename(name string) error {
+	return os.Rename(d.path.String(), d.path.Dir().Join(fs.Path(name)).String())
+}
+
 //Path returns a path representing the objects absolute location.
 func (d data) Path() fs.Path {
 	return d.path
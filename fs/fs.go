@@ -45,6 +45,12 @@ type Data interface {
 	Delete() error
 }
 
+//Renamer is implemented by Data that can be renamed within its directory.
+//Any data that already has the new name is replaced at once, so that it is never left partially written.
+type Renamer interface {
+	Rename(name string) error
+}
+
 //File contains Data
 type File struct {
 	Data